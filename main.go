@@ -6,7 +6,9 @@ package main
 import (
 	"container/list"
 	"fmt"
+	"strings"
 
+	"github.com/mailru/easyjson/jlexer"
 	"github.com/mailru/easyjson/jwriter"
 	"github.com/un-ts/sh-syntax/processor"
 
@@ -46,15 +48,29 @@ func Parse(text string, filepath string, parserOptions processor.ParserOptions)
 	return processor.Parse(text, filepath, parserOptions)
 }
 
-func Print(originalText string, filepath string, syntaxOptions processor.SyntaxOptions) (string, error) {
+func Print(originalText string, filepath string, syntaxOptions processor.SyntaxOptions) (string, processor.Report, error) {
 	return processor.Print(originalText, filepath, syntaxOptions)
 }
 
-// `process` processes the input file path and text by performing either formatting (printing) or parsing based on the print flag.
+// `process` processes the input file path and text by performing either formatting (printing), linting, symbol table building, command or environment variable listing, include graph building or parsing based on the print, lint, symbols, commands, environment and includes flags.
 //
-// It converts the input byte slices to strings and configures parser options—including comment retention, language variant, stop marker, and error recovery settings. When printing is enabled, it applies printer options (indentation, binary next line, switch case indentation, space redirects, padding, minification, single-line formatting, and function next line) to format the text via the Print function. Otherwise, it parses the text with Parse and maps the resulting AST into a file representation.
+// It converts the input byte slices to strings and configures parser options—including comment retention, language variant, stop marker, and error recovery settings. When printing is enabled, it applies printer options (indentation, binary next line, switch case indentation, space redirects, padding, minification, single-line formatting, function next line, print width, quote style, line ending, blank lines, alignment, function declaration style, and `[[ ]]` test conversion), resolved from the preset and the EditorConfig files, to format the text via the Print function, optionally simplifying, verifying, mapping, reporting backquotes and lost comments, repeating until a fixed point, and keeping the regions which cannot be parsed verbatim.
 //
-// The function then encapsulates the file, the processed text, and any parsing error information into a result structure, marshals it to JSON, appends a null terminator, and returns a pointer to the first byte of the JSON output.
+// When linting is enabled instead, it runs the lint rules enabled by the rules configuration via processor.Lint.
+//
+// When a new name is given, it returns the edits renaming the symbol at offset to it via processor.Rename as fixes of the report.
+//
+// When the symbols flag is set, it builds the scope tree of the variables and functions of the text via processor.Symbols, or finds the definitions and references of the one at offset via processor.SymbolAt if offset is not negative.
+//
+// When the commands flag is set, it lists the builtins, functions and external programs run by the text via processor.Commands.
+//
+// When the environment flag is set, it lists the variables the text reads without assigning them via processor.Environment.
+//
+// When the includes flag is set, it builds the graph of the files the text includes with `source` or `.`, looked up in the files map, via processor.Includes.
+//
+// Otherwise, it parses the text with Parse and maps the resulting AST into a file representation.
+//
+// The function then encapsulates the file, the processed text, the print report, the lint diagnostics, the scope tree or symbol, the command inventory, the environment variables, the include graph, and any parsing error information into a result structure, marshals it to JSON, appends a null terminator, and returns a pointer to the first byte of the JSON output.
 //
 //export process
 func process(
@@ -62,6 +78,13 @@ func process(
 	textBytes []byte,
 
 	print bool,
+	lint bool,
+	symbols bool,
+	offset int,
+	rename []byte,
+	commands bool,
+	environment bool,
+	includes bool,
 
 	// parser
 	keepComments bool,
@@ -78,6 +101,34 @@ func process(
 	minify,
 	singleLine bool,
 	functionNextLine bool,
+	printWidth int,
+	singleQuote,
+	braceParams,
+	plainAnsiCQuotes bool,
+	lineEnding int,
+	maxBlankLines int,
+	alignComments,
+	alignAssignments bool,
+	functionStyle int,
+	doubleBrackets bool,
+	preset []byte,
+	editorConfigs []byte,
+	overrides []byte,
+
+	// syntax
+	simplify,
+	sourceMap,
+	verify,
+	fixedPoint,
+	tolerant,
+	reportBackquotes,
+	checkComments bool,
+
+	// lint
+	rules []byte,
+
+	// includes
+	files []byte,
 ) *byte {
 	filepath := string(filepathBytes)
 	text := string(textBytes)
@@ -89,26 +140,84 @@ func process(
 		RecoverErrors: recoverErrors,
 	}
 
+	printerOptions := processor.PrinterOptions{
+		Indent:           uint(indent),
+		BinaryNextLine:   binaryNextLine,
+		SwitchCaseIndent: switchCaseIndent,
+		SpaceRedirects:   spaceRedirects,
+		KeepPadding:      keepPadding,
+		Minify:           minify,
+		SingleLine:       singleLine,
+		FunctionNextLine: functionNextLine,
+		PrintWidth:       uint(printWidth),
+		SingleQuote:      singleQuote,
+		BraceParams:      braceParams,
+		PlainAnsiCQuotes: plainAnsiCQuotes,
+		LineEnding:       processor.LineEnding(lineEnding),
+		MaxBlankLines:    uint(maxBlankLines),
+		AlignComments:    alignComments,
+		AlignAssignments: alignAssignments,
+		FunctionStyle:    processor.FunctionStyle(functionStyle),
+		DoubleBrackets:   doubleBrackets,
+	}
+
 	var file processor.File
+	var report processor.Report
+	var diagnostics []processor.Diagnostic
+	var scope *processor.Scope
+	var symbol *processor.Symbol
+	var inventory *processor.CommandInventory
+	var variables []processor.EnvironmentVariable
+	var graph *processor.IncludeGraph
 	var error error
 
 	if print {
-		printerOptions := processor.PrinterOptions{
-			Indent:           uint(indent),
-			BinaryNextLine:   binaryNextLine,
-			SwitchCaseIndent: switchCaseIndent,
-			SpaceRedirects:   spaceRedirects,
-			KeepPadding:      keepPadding,
-			Minify:           minify,
-			SingleLine:       singleLine,
-			FunctionNextLine: functionNextLine,
-		}
+		text, report, error = Print(text, filepath, processor.SyntaxOptions{
+			ParserOptions:    parserOptions,
+			PrinterOptions:   printerOptions,
+			Simplify:         simplify,
+			SourceMap:        sourceMap,
+			Verify:           verify,
+			FixedPoint:       fixedPoint,
+			Tolerant:         tolerant,
+			ReportBackquotes: reportBackquotes,
+			CheckComments:    checkComments,
+			Preset:           string(preset),
+			EditorConfigs:    unmarshalEditorConfigs(editorConfigs),
+			Overrides:        strings.FieldsFunc(string(overrides), func(r rune) bool { return r == ',' }),
+		})
 
-		text, error = Print(text, filepath, processor.SyntaxOptions{
+	} else if lint {
+		diagnostics, error = processor.Lint(text, filepath, processor.LintOptions{
 			ParserOptions:  parserOptions,
 			PrinterOptions: printerOptions,
+			Rules:          unmarshalRules(rules),
 		})
 
+	} else if len(rename) > 0 && offset >= 0 {
+		report.Fixes, report.Warnings, error = processor.Rename(text, filepath, parserOptions, uint(offset), string(rename))
+
+	} else if symbols && offset >= 0 {
+		symbol, error = processor.SymbolAt(text, filepath, parserOptions, uint(offset))
+
+	} else if symbols {
+		var symbolTable processor.Scope
+		symbolTable, error = processor.Symbols(text, filepath, parserOptions)
+		scope = &symbolTable
+
+	} else if commands {
+		var commandInventory processor.CommandInventory
+		commandInventory, error = processor.Commands(text, filepath, parserOptions)
+		inventory = &commandInventory
+
+	} else if environment {
+		variables, error = processor.Environment(text, filepath, parserOptions)
+
+	} else if includes {
+		var includeGraph processor.IncludeGraph
+		includeGraph, error = processor.Includes(text, filepath, unmarshalFiles(files), parserOptions)
+		graph = &includeGraph
+
 	} else {
 		astFile, err := Parse(text, filepath, parserOptions)
 		file = processor.MapFile(*astFile)
@@ -118,12 +227,81 @@ func process(
 	parseError, message := processor.MapParseError(error)
 
 	result := processor.Result{
-		File:       file,
-		Text:       text,
-		ParseError: parseError,
-		Message:    message,
+		File:        file,
+		Text:        text,
+		ParseError:  parseError,
+		Message:     message,
+		Report:      report,
+		Diagnostics: diagnostics,
+		Symbols:     scope,
+		Symbol:      symbol,
+		Commands:    inventory,
+		Environment: variables,
+		Includes:    graph,
 	}
 
+	return marshalResult(result)
+}
+
+// workspace holds the documents of the workspace functions, which keep them across calls.
+var workspace = processor.NewWorkspace()
+
+// `workspaceUpdate` parses the text as the document at the file path of the workspace, adding it or
+// replacing its previous text, via processor.Workspace.Update. It returns the parsing error of the
+// document in a result structure marshaled to JSON, if any, as process does.
+//
+//export workspaceUpdate
+func workspaceUpdate(
+	filepathBytes []byte,
+	textBytes []byte,
+
+	// parser
+	keepComments bool,
+	variant int,
+	stopAt []byte,
+	recoverErrors int,
+) *byte {
+	parseError, message := processor.MapParseError(workspace.Update(string(filepathBytes), string(textBytes), processor.ParserOptions{
+		KeepComments:  keepComments,
+		Variant:       syntax.LangVariant(variant),
+		StopAt:        string(stopAt),
+		RecoverErrors: recoverErrors,
+	}))
+
+	return marshalResult(processor.Result{ParseError: parseError, Message: message})
+}
+
+// `workspaceRemove` removes the document at the file path from the workspace.
+//
+//export workspaceRemove
+func workspaceRemove(filepathBytes []byte) {
+	workspace.Remove(string(filepathBytes))
+}
+
+// `workspaceSymbol` finds the variable or function at offset in the document at the file path of the
+// workspace, with its definitions and references across the documents sharing it, via
+// processor.Workspace.SymbolAt, and returns it in a result structure marshaled to JSON as process does.
+//
+//export workspaceSymbol
+func workspaceSymbol(filepathBytes []byte, offset int) *byte {
+	symbol, err := workspace.SymbolAt(string(filepathBytes), uint(offset))
+	parseError, message := processor.MapParseError(err)
+
+	return marshalResult(processor.Result{ParseError: parseError, Message: message, Workspace: symbol})
+}
+
+// `workspaceUnusedFunctions` lists the functions of the workspace which are never called via
+// processor.Workspace.UnusedFunctions, and returns them in a result structure marshaled to JSON as
+// process does.
+//
+//export workspaceUnusedFunctions
+func workspaceUnusedFunctions() *byte {
+	return marshalResult(processor.Result{Unused: workspace.UnusedFunctions()})
+}
+
+// `marshalResult` marshals result to JSON, appends a null terminator, and returns a pointer to the
+// first byte of the JSON output.
+func marshalResult(result processor.Result) *byte {
 	// Marshal via jwriter directly rather than easyjson.Marshal, whose package
 	// pulls in net/http (through the unused MarshalToHTTPResponseWriter helper),
 	// which TinyGo cannot compile for the js/wasm target.
@@ -141,5 +319,51 @@ func process(
 	return &bytes[0]
 }
 
+// `unmarshalEditorConfigs` decodes the JSON array of .editorconfig files passed to process.
+func unmarshalEditorConfigs(data []byte) []processor.EditorConfig {
+	var configs []processor.EditorConfig
+
+	in := jlexer.Lexer{Data: data}
+	in.Delim('[')
+	for !in.IsDelim(']') && in.Ok() {
+		var config processor.EditorConfig
+		config.UnmarshalEasyJSON(&in)
+		configs = append(configs, config)
+		in.WantComma()
+	}
+
+	return configs
+}
+
+// `unmarshalFiles` decodes the files passed to process, a JSON object mapping their paths to their content.
+func unmarshalFiles(data []byte) map[string]string {
+	files := map[string]string{}
+
+	in := jlexer.Lexer{Data: data}
+	in.Delim('{')
+	for !in.IsDelim('}') && in.Ok() {
+		path := in.String()
+		in.WantColon()
+		files[path] = in.String()
+		in.WantComma()
+	}
+
+	return files
+}
+
+// `unmarshalRules` decodes the lint rule configuration passed to process, a comma-separated list such as
+// `backquote=off,unquoted-expansion=error`.
+func unmarshalRules(data []byte) map[string]string {
+	config := map[string]string{}
+
+	for _, field := range strings.FieldsFunc(string(data), func(r rune) bool { return r == ',' }) {
+		if id, value, ok := strings.Cut(field, "="); ok {
+			config[id] = value
+		}
+	}
+
+	return config
+}
+
 func main() {
 }
@@ -0,0 +1,88 @@
+package processor
+
+import (
+	"bytes"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// `backquoteFixes` reports every backquoted command substitution of file as a fix to its `$(...)` form.
+//
+// syntax.Printer always prints command substitutions as `$(...)`, and the lexer has already removed the
+// backslashes which were only needed to escape nested backquotes, so the conversion itself happens
+// when printing. The replacement text is printed with printerOptions so it matches the final output.
+// Inline comments written as `# comment` between backquotes are kept as is by the printer and not reported.
+// Nested backquotes are left out, as the fix of the outermost one already covers them, and their
+// escaped range in originalText could not be replaced on its own.
+func backquoteFixes(file *syntax.File, originalText string, printerOptions PrinterOptions) ([]Fix, error) {
+	fixes := []Fix{}
+	p := newPrinter(printerOptions)
+
+	var err error
+
+	syntax.Walk(file, func(node syntax.Node) bool {
+		if err != nil {
+			return false
+		}
+		cs, ok := node.(*syntax.CmdSubst)
+		if !ok {
+			return true
+		}
+
+		var fix *Fix
+		if fix, err = backquoteFix(p, cs, originalText); fix != nil {
+			fixes = append(fixes, *fix)
+			return false
+		}
+
+		return err == nil
+	})
+
+	return fixes, err
+}
+
+// `backquoteFix` returns the fix of cs to its `$(...)` form printed with p, or nil if it is not
+// backquoted or only holds an inline comment.
+func backquoteFix(p *syntax.Printer, cs *syntax.CmdSubst, originalText string) (*Fix, error) {
+	if !cs.Backquotes {
+		return nil, nil
+	}
+	if len(cs.Stmts) == 0 && len(cs.Last) == 1 && cs.Left.Line() == cs.Right.Line() {
+		return nil, nil
+	}
+
+	var buf bytes.Buffer
+	if err := p.Print(&buf, cs); err != nil {
+		return nil, err
+	}
+
+	pos, end := cs.Pos(), cs.End()
+
+	return &Fix{
+		Message: "use $(...) instead of legacy backquotes",
+		OldText: originalText[pos.Offset():end.Offset()],
+		NewText: buf.String(),
+		Pos:     mapPos(pos),
+		End:     mapPos(end),
+	}, nil
+}
+
+// backquoteRule reports legacy backquoted command substitutions, with a fix to their `$(...)` form.
+// Nested ones are left out, as the fix of the outermost one already covers them.
+var backquoteRule = Rule{
+	ID:       "backquote",
+	Severity: SeverityStyle,
+	Check: func(l *Linter, node syntax.Node) {
+		cs, ok := node.(*syntax.CmdSubst)
+		if !ok {
+			return
+		}
+		if n := len(l.diagnostics); n > 0 && l.diagnostics[n-1].End.Offset >= cs.End().Offset() {
+			return
+		}
+
+		if fix, err := backquoteFix(l.printer, cs, l.Text); fix != nil && err == nil {
+			l.Report(cs.Pos(), cs.End(), fix.Message, fix)
+		}
+	},
+}
@@ -0,0 +1,366 @@
+package processor
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// `[` reads numbers such as `010` or `+8` as decimal, while `[[ ]]` reads them as arithmetic
+// expressions, in which `010` is octal.
+var decimalRegexp = regexp.MustCompile(`^(?:0|-?[1-9][0-9]*)$`)
+
+var (
+	unaryTestOperators = map[string]syntax.UnTestOperator{"-a": syntax.TsExists}
+	// `<` and `>` are left out, as they compare strings in the collation order of the locale within
+	// `[[ ]]`, but byte by byte within `[`.
+	binaryTestOperators = map[string]syntax.BinTestOperator{
+		"=":  syntax.TsMatchShort,
+		"==": syntax.TsMatch,
+		"!=": syntax.TsNoMatch,
+	}
+	// arithmTestOperators evaluate their operands as arithmetic expressions within `[[ ]]`.
+	arithmTestOperators = map[syntax.BinTestOperator]bool{}
+)
+
+func init() {
+	// `-o` is left out, as it is the or operator of `[`.
+	for op := syntax.TsExists; op <= syntax.TsRefVar; op++ {
+		if op != syntax.TsOptSet {
+			unaryTestOperators[op.String()] = op
+		}
+	}
+	for op := syntax.TsNewer; op <= syntax.TsGtr; op++ {
+		binaryTestOperators[op.String()] = op
+		if op >= syntax.TsEql {
+			arithmTestOperators[op] = true
+		}
+	}
+}
+
+// `doubleBracketFixes` rewrites the `[` and `test` commands of file in place into `[[ ]]` test clauses,
+// returning a fix for each command rewritten. Only the Bash, mksh and Zsh variants have test clauses,
+// so nothing is rewritten for the other ones.
+//
+// Commands are left as they are wherever the rewrite could change their behaviour: when an operand
+// has an unquoted expansion, which `[` splits into fields but `[[ ]]` does not, an unquoted glob or
+// brace which `[` expands but `[[ ]]` matches as a pattern, an arithmetic comparison with an
+// expansion other than a special parameter, which `[[ ]]` would evaluate as an arithmetic expression,
+// an operand which `[[ ]]` would read as an operator, or a string comparison with `<` or `>`.
+func doubleBracketFixes(file *syntax.File, originalText string, printerOptions PrinterOptions, variant syntax.LangVariant) ([]Fix, error) {
+	fixes := []Fix{}
+
+	switch variant {
+	case syntax.LangBash, syntax.LangBats, syntax.LangMirBSDKorn, syntax.LangZsh:
+	default:
+		return fixes, nil
+	}
+
+	p := newPrinter(printerOptions)
+
+	var err error
+
+	syntax.Walk(file, func(node syntax.Node) bool {
+		if err != nil {
+			return false
+		}
+		stmt, ok := node.(*syntax.Stmt)
+		if !ok {
+			return true
+		}
+		call, ok := stmt.Cmd.(*syntax.CallExpr)
+		if !ok {
+			return true
+		}
+		tc := testClause(call)
+		if tc == nil {
+			return true
+		}
+
+		var buf bytes.Buffer
+		if err = p.Print(&buf, tc); err != nil {
+			return false
+		}
+
+		pos, end := call.Pos(), call.End()
+
+		fixes = append(fixes, Fix{
+			Message: "use [[ ]] instead of [ ] or test",
+			OldText: originalText[pos.Offset():end.Offset()],
+			NewText: buf.String(),
+			Pos:     mapPos(pos),
+			End:     mapPos(end),
+		})
+
+		stmt.Cmd = tc
+
+		return true
+	})
+
+	return fixes, err
+}
+
+// `testClause` returns the test clause equivalent to call if it is a `[` or `test` command which can be
+// rewritten, or nil otherwise.
+func testClause(call *syntax.CallExpr) *syntax.TestClause {
+	if len(call.Assigns) > 0 || len(call.Args) == 0 {
+		return nil
+	}
+
+	args := call.Args[1:]
+
+	switch call.Args[0].Lit() {
+	case "[":
+		if len(args) == 0 || args[len(args)-1].Lit() != "]" {
+			return nil
+		}
+		args = args[:len(args)-1]
+	case "test":
+	default:
+		return nil
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	tp := testParser{args: args}
+	x := tp.parse()
+
+	if x == nil || !tp.safe {
+		return nil
+	}
+
+	// The test clause ends two columns after Right, where the command ends.
+	end := call.End()
+	right := syntax.NewPos(end.Offset()-2, end.Line(), end.Col()-2)
+
+	return &syntax.TestClause{Left: call.Pos(), Right: right, X: x}
+}
+
+// `testParser` parses the arguments of a `[` or `test` command into a test expression, following the
+// rules of POSIX for up to three arguments, and precedence rules otherwise: `!` binds tighter than the
+// binary operators, which bind tighter than `-a`, which binds tighter than `-o`.
+type testParser struct {
+	args []*syntax.Word
+	i    int
+	// safe is cleared when an operand would behave differently within `[[ ]]`.
+	safe bool
+}
+
+func (tp *testParser) parse() syntax.TestExpr {
+	tp.safe = true
+
+	var x syntax.TestExpr
+
+	switch len(tp.args) {
+	case 1:
+		x = tp.operand()
+	case 2:
+		if tp.op(0) == "!" {
+			tp.i++
+			x = &syntax.UnaryTest{OpPos: tp.args[0].Pos(), Op: syntax.TsNot, X: tp.operand()}
+		} else if op, ok := unaryTestOperators[tp.op(0)]; ok {
+			tp.i++
+			x = &syntax.UnaryTest{OpPos: tp.args[0].Pos(), Op: op, X: tp.operand()}
+		}
+	case 3:
+		if op, ok := binaryTestOperators[tp.op(1)]; ok {
+			x = tp.binary(op)
+		} else if tp.op(0) == "!" || (tp.op(0) == "(" && tp.op(2) == ")") {
+			x = tp.or()
+		}
+	default:
+		x = tp.or()
+	}
+
+	if tp.i != len(tp.args) {
+		return nil
+	}
+
+	return x
+}
+
+// `testOperatorWord` reports whether word is read as an operator rather than a string within `[[ ]]`.
+func testOperatorWord(word string) bool {
+	switch word {
+	case "!", "(", ")", "<", ">":
+		return true
+	}
+	_, ok := binaryTestOperators[word]
+	return ok
+}
+
+// `op` returns the operator at index i of the arguments, or an empty string if it is not a plain word.
+// Operators such as `\(` or `\<` are escaped within `[` so the shell does not read them itself.
+func (tp *testParser) op(i int) string {
+	if i >= len(tp.args) {
+		return ""
+	}
+	lit := tp.args[i].Lit()
+	if len(lit) == 2 && lit[0] == '\\' {
+		return lit[1:]
+	}
+	return lit
+}
+
+func (tp *testParser) or() syntax.TestExpr {
+	return tp.logical(syntax.OrTest, "-o", tp.and)
+}
+
+func (tp *testParser) and() syntax.TestExpr {
+	return tp.logical(syntax.AndTest, "-a", tp.not)
+}
+
+// `logical` parses a chain of operands separated by word, the `[` form of op, with next.
+//
+// The chain is built from the right, as the parser does for `[[ ]]`, and a chain of the other logical
+// operator as left operand is put in parentheses, as the parser would otherwise not respect the
+// precedence of `&&` over `||` when reading it back.
+func (tp *testParser) logical(op syntax.BinTestOperator, word string, next func() syntax.TestExpr) syntax.TestExpr {
+	x := next()
+	if x == nil {
+		return nil
+	}
+
+	operands := []syntax.TestExpr{x}
+	var opPositions []syntax.Pos
+
+	for tp.op(tp.i) == word {
+		opPositions = append(opPositions, tp.args[tp.i].Pos())
+		tp.i++
+		y := next()
+		if y == nil {
+			return nil
+		}
+		operands = append(operands, y)
+	}
+
+	x = operands[len(operands)-1]
+	for i := len(operands) - 2; i >= 0; i-- {
+		left := operands[i]
+		if bt, ok := left.(*syntax.BinaryTest); ok && (bt.Op == syntax.AndTest || bt.Op == syntax.OrTest) {
+			left = &syntax.ParenTest{Lparen: left.Pos(), Rparen: left.End(), X: left}
+		}
+		x = &syntax.BinaryTest{OpPos: opPositions[i], Op: op, X: left, Y: x}
+	}
+
+	return x
+}
+
+func (tp *testParser) not() syntax.TestExpr {
+	if _, ok := binaryTestOperators[tp.op(tp.i+1)]; tp.op(tp.i) != "!" || (ok && tp.i+2 < len(tp.args)) {
+		return tp.primary()
+	}
+
+	opPos := tp.args[tp.i].Pos()
+	tp.i++
+
+	x := tp.not()
+	if x == nil {
+		return nil
+	}
+
+	return &syntax.UnaryTest{OpPos: opPos, Op: syntax.TsNot, X: x}
+}
+
+func (tp *testParser) primary() syntax.TestExpr {
+	if tp.i >= len(tp.args) {
+		return nil
+	}
+
+	if op, ok := binaryTestOperators[tp.op(tp.i+1)]; ok && tp.i+2 < len(tp.args) {
+		return tp.binary(op)
+	}
+
+	if tp.op(tp.i) == "(" {
+		lparen := tp.args[tp.i].Pos()
+		tp.i++
+
+		x := tp.or()
+		if x == nil || tp.op(tp.i) != ")" {
+			return nil
+		}
+
+		rparen := tp.args[tp.i].Pos()
+		tp.i++
+
+		return &syntax.ParenTest{Lparen: lparen, Rparen: rparen, X: x}
+	}
+
+	if op, ok := unaryTestOperators[tp.op(tp.i)]; ok && tp.i+1 < len(tp.args) {
+		opPos := tp.args[tp.i].Pos()
+		tp.i++
+		return &syntax.UnaryTest{OpPos: opPos, Op: op, X: tp.operand()}
+	}
+
+	return tp.operand()
+}
+
+// `binary` parses the binary test at the current argument, whose operator is op.
+func (tp *testParser) binary(op syntax.BinTestOperator) syntax.TestExpr {
+	x := tp.operand()
+	opPos := tp.args[tp.i].Pos()
+	tp.i++
+	y := tp.operand()
+
+	if arithmTestOperators[op] && (!numericOperand(x.(*syntax.Word)) || !numericOperand(y.(*syntax.Word))) {
+		tp.safe = false
+	}
+
+	return &syntax.BinaryTest{OpPos: opPos, Op: op, X: x, Y: y}
+}
+
+// `operand` returns the current argument as an operand, clearing safe if it has an unquoted expansion,
+// glob or brace, or if `[[ ]]` would read it as an operator: `[ -n ]` tests whether `-n` is a non-empty
+// string, while `[[ -n ]]` is a syntax error. Numeric special parameters such as `$#` never expand to
+// more than one field.
+func (tp *testParser) operand() syntax.TestExpr {
+	word := tp.args[tp.i]
+	tp.i++
+
+	if numericOperand(word) {
+		return word
+	}
+
+	if lit := word.Lit(); strings.HasPrefix(lit, "-") || testOperatorWord(lit) {
+		tp.safe = false
+	}
+
+	for _, part := range word.Parts {
+		switch part := part.(type) {
+		case *syntax.Lit:
+			if strings.ContainsAny(part.Value, "*?[{") {
+				tp.safe = false
+			}
+		case *syntax.SglQuoted, *syntax.DblQuoted:
+		default:
+			tp.safe = false
+		}
+	}
+
+	return word
+}
+
+// `numericOperand` reports whether word is a literal decimal number, which `[` and `[[ ]]` read alike,
+// or a parameter expansion which always expands to one, such as `$#` or `${#a}`.
+func numericOperand(word *syntax.Word) bool {
+	if lit := word.Lit(); lit != "" {
+		_, err := strconv.ParseInt(lit, 10, 64)
+		return err == nil && decimalRegexp.MatchString(lit)
+	}
+
+	if len(word.Parts) == 1 {
+		if dq, ok := word.Parts[0].(*syntax.DblQuoted); ok && len(dq.Parts) == 1 {
+			return numericOperand(&syntax.Word{Parts: dq.Parts})
+		}
+		if pe, ok := word.Parts[0].(*syntax.ParamExp); ok {
+			return numericParamExp(pe)
+		}
+	}
+
+	return false
+}
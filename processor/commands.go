@@ -0,0 +1,190 @@
+package processor
+
+import (
+	"strings"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// builtins are the builtin commands of Bash which are parsed as simple commands, so without `declare`,
+// `local` and the other ones syntax.Parser reads as clauses.
+var builtins = map[string]bool{}
+
+func init() {
+	for _, name := range strings.Fields(`. : [ alias bg bind break builtin caller cd command compgen complete
+		compopt continue dirs disown echo enable eval exec exit false fc fg getopts hash help history jobs
+		kill logout mapfile popd printf pushd pwd read readarray return set shift shopt source suspend test
+		times trap true type ulimit umask unalias unset wait`) {
+		builtins[name] = true
+	}
+}
+
+// commandWrappers are the commands running another one given as argument, with the options of each of
+// them which take a separate value. Those run by `sudo`, `env` and `xargs` are always external ones.
+var commandWrappers = map[string]string{
+	"command": "",
+	"exec":    "a",
+	"sudo":    "CDghpRrtTUu",
+	"env":     "CSu",
+	"xargs":   "adEILnPs",
+}
+
+// `Commands` parses originalText and returns the commands it runs, separated into builtins, functions
+// defined in the script and external programs, each with the positions of its name and a count. The
+// commands run by `command`, `exec`, `sudo`, `env` and `xargs` are listed along with these wrappers,
+// skipping their options and the assignments given to `env` and `sudo`, except for the commands only
+// looked up by `command -v` or `command -V`. Commands whose name is not a literal word, such as `$cmd`,
+// are left out. Every position points into originalText as it is, as with Print.
+func Commands(originalText string, filepath string, parserOptions ParserOptions) (CommandInventory, error) {
+	text, original := normalizeLineEndings(originalText)
+
+	file, err := Parse(text, filepath, parserOptions)
+
+	if err != nil {
+		return CommandInventory{}, original.err(err)
+	}
+
+	functions := map[string]bool{}
+
+	syntax.Walk(file, func(node syntax.Node) bool {
+		if fd, ok := node.(*syntax.FuncDecl); ok && fd.Name != nil {
+			functions[fd.Name.Value] = true
+		}
+		return true
+	})
+
+	inventory := CommandInventory{Builtins: []Command{}, Functions: []Command{}, External: []Command{}}
+
+	add := func(commands *[]Command, name string, word *syntax.Word) {
+		node := Node{Pos: original.pos(mapPos(word.Pos())), End: original.pos(mapPos(word.End()))}
+		for i := range *commands {
+			if command := &(*commands)[i]; command.Name == name {
+				command.Count++
+				command.Calls = append(command.Calls, node)
+				return
+			}
+		}
+		*commands = append(*commands, Command{Name: name, Count: 1, Calls: []Node{node}})
+	}
+
+	syntax.Walk(file, func(node syntax.Node) bool {
+		call, ok := node.(*syntax.CallExpr)
+		if !ok {
+			return true
+		}
+
+		args := call.Args
+		// wrapper is the last wrapper running the command, if any
+		wrapper := ""
+
+		for len(args) > 0 {
+			name, ok := literalWord(args[0])
+			if !ok || name == "" {
+				break
+			}
+
+			switch {
+			case wrapper == "" && functions[name]:
+				add(&inventory.Functions, name, args[0])
+			case builtins[name] && (wrapper == "" || wrapper == "command" || wrapper == "exec"):
+				add(&inventory.Builtins, name, args[0])
+			default:
+				add(&inventory.External, name, args[0])
+			}
+
+			options, ok := commandWrappers[name]
+			if !ok {
+				break
+			}
+
+			wrapper = name
+			args = skipWrapperOptions(name, options, args[1:])
+		}
+
+		return true
+	})
+
+	return inventory, nil
+}
+
+// `skipWrapperOptions` returns args without the leading options of the wrapper command name, those of
+// which options take a separate value, and the assignments given to `env` and `sudo`, or nothing if the
+// options keep the command from being run.
+func skipWrapperOptions(name string, options string, args []*syntax.Word) []*syntax.Word {
+	for len(args) > 0 {
+		arg, _ := literalWord(args[0])
+
+		switch {
+		case arg == "--":
+			return args[1:]
+		case arg == "-" && name == "env", strings.HasPrefix(arg, "--"):
+			// long options taking a value are expected to be given it after `=`
+			args = args[1:]
+		case name == "command" && strings.HasPrefix(arg, "-") && strings.ContainsAny(arg, "vV"):
+			// `command -v` and `command -V` only describe the command instead of running it
+			return nil
+		case strings.HasPrefix(arg, "-") && len(arg) > 1:
+			args = args[1:]
+			if strings.IndexByte(options, arg[len(arg)-1]) >= 0 && len(args) > 0 {
+				args = args[1:]
+			}
+		case (name == "env" || name == "sudo") && strings.Contains(arg, "=") && syntax.ValidName(arg[:strings.IndexByte(arg, '=')]):
+			args = args[1:]
+		default:
+			return args
+		}
+	}
+
+	return args
+}
+
+// `literalWord` returns the value of word if it only consists of literals and quotes without any
+// expansion, such as `ls`, `'ls'`, `"ls"` or `\ls`, with its quotes and escapes removed.
+func literalWord(word *syntax.Word) (string, bool) {
+	var sb strings.Builder
+
+	for _, part := range word.Parts {
+		switch part := part.(type) {
+		case *syntax.Lit:
+			sb.WriteString(unescape(part.Value, ""))
+		case *syntax.SglQuoted:
+			if part.Dollar {
+				return "", false
+			}
+			sb.WriteString(part.Value)
+		case *syntax.DblQuoted:
+			if part.Dollar {
+				return "", false
+			}
+			for _, part := range part.Parts {
+				lit, ok := part.(*syntax.Lit)
+				if !ok {
+					return "", false
+				}
+				sb.WriteString(unescape(lit.Value, "$`\"\\\n"))
+			}
+		default:
+			return "", false
+		}
+	}
+
+	return sb.String(), true
+}
+
+// `unescape` removes the backslashes of value escaping the next character, which is any character
+// outside of quotes, or one of special within double quotes. An escaped newline is removed as well.
+func unescape(value string, special string) string {
+	var sb strings.Builder
+
+	for i := 0; i < len(value); i++ {
+		if value[i] == '\\' && i+1 < len(value) && (special == "" || strings.IndexByte(special, value[i+1]) >= 0) {
+			i++
+			if value[i] == '\n' {
+				continue
+			}
+		}
+		sb.WriteByte(value[i])
+	}
+
+	return sb.String()
+}
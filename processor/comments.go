@@ -0,0 +1,114 @@
+package processor
+
+import (
+	"fmt"
+	"strings"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// `attachedComment` is a comment along with the node it is attached to, identified by its type and its
+// index in walk order so that it can be matched with the same node of another tree.
+type attachedComment struct {
+	comment    *syntax.Comment
+	owner      syntax.Node
+	ownerIndex int
+}
+
+// `countComments` returns the number of comments in file.
+func countComments(file *syntax.File) int {
+	count := 0
+	syntax.Walk(file, func(node syntax.Node) bool {
+		if _, ok := node.(*syntax.Comment); ok {
+			count++
+		}
+		return true
+	})
+	return count
+}
+
+// `commentWarnings` compares the comments of file, the tree which was printed, with the ones of
+// formatted, the syntax tree of the formatted output, and warns about every comment which was dropped,
+// or which is no longer attached to the same node.
+//
+// Comments are matched by text, in order of appearance, so a comment whose text changed is reported
+// as dropped.
+func commentWarnings(file *syntax.File, formatted *syntax.File) []Warning {
+	remaining := map[string][]attachedComment{}
+	for _, c := range attachedComments(formatted) {
+		remaining[c.comment.Text] = append(remaining[c.comment.Text], c)
+	}
+
+	warnings := []Warning{}
+
+	for _, c := range attachedComments(file) {
+		candidates := remaining[c.comment.Text]
+
+		warning := Warning{
+			Text: c.comment.Text,
+			Pos:  mapPos(c.comment.Pos()),
+			End:  mapPos(c.comment.End()),
+		}
+
+		if len(candidates) == 0 {
+			warning.Message = "comment was dropped by the printer"
+			warnings = append(warnings, warning)
+			continue
+		}
+
+		match := candidates[0]
+		remaining[c.comment.Text] = candidates[1:]
+
+		if match.ownerIndex != c.ownerIndex || nodeName(match.owner) != nodeName(c.owner) {
+			warning.Message = fmt.Sprintf(
+				"comment attached to %s at %s was moved to %s at %s",
+				nodeName(c.owner), c.owner.Pos(), nodeName(match.owner), match.owner.Pos(),
+			)
+			warnings = append(warnings, warning)
+		}
+	}
+
+	return warnings
+}
+
+// `attachedComments` lists the comments of file in order of appearance, along with their owners.
+//
+// syntax.Walk visits the comments trailing a statement after the statement itself, so these are
+// attached to their statement up front rather than to the node being walked when they are visited.
+func attachedComments(file *syntax.File) []attachedComment {
+	var comments []attachedComment
+	var stack []syntax.Node
+
+	indexes := map[syntax.Node]int{}
+	stmtComments := map[syntax.Pos]syntax.Node{}
+
+	syntax.Walk(file, func(node syntax.Node) bool {
+		switch node := node.(type) {
+		case nil:
+			stack = stack[:len(stack)-1]
+			return true
+		case *syntax.Comment:
+			owner, ok := stmtComments[node.Hash]
+			if !ok {
+				owner = stack[len(stack)-1]
+			}
+			comments = append(comments, attachedComment{comment: node, owner: owner, ownerIndex: indexes[owner]})
+		case *syntax.Stmt:
+			for _, c := range node.Comments {
+				stmtComments[c.Hash] = node
+			}
+		}
+		if _, ok := node.(*syntax.Comment); !ok {
+			indexes[node] = len(indexes)
+		}
+		stack = append(stack, node)
+		return true
+	})
+
+	return comments
+}
+
+// `nodeName` returns the type name of node, without its package.
+func nodeName(node syntax.Node) string {
+	return strings.TrimPrefix(fmt.Sprintf("%T", node), "*syntax.")
+}
@@ -0,0 +1,137 @@
+package processor
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffContext is the number of unchanged lines shown around each change in a unified diff.
+const diffContext = 3
+
+type diffLine struct {
+	op   byte
+	text string
+}
+
+// `unifiedDiff` returns the line-based unified diff from a to b, with fromName and toName as headers,
+// or an empty string if both are equal.
+//
+// The common prefix and suffix are trimmed before computing the longest common subsequence of the
+// remaining lines, which keeps the quadratic part small for the few lines a formatter usually changes.
+func unifiedDiff(a string, b string, fromName string, toName string) string {
+	if a == b {
+		return ""
+	}
+
+	x, y := splitLines(a), splitLines(b)
+
+	prefix := 0
+	for prefix < len(x) && prefix < len(y) && x[prefix] == y[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(x)-prefix && suffix < len(y)-prefix && x[len(x)-1-suffix] == y[len(y)-1-suffix] {
+		suffix++
+	}
+
+	var lines []diffLine
+	for _, line := range x[:prefix] {
+		lines = append(lines, diffLine{' ', line})
+	}
+	lines = append(lines, diffLines(x[prefix:len(x)-suffix], y[prefix:len(y)-suffix])...)
+	for _, line := range x[len(x)-suffix:] {
+		lines = append(lines, diffLine{' ', line})
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", fromName, toName)
+
+	for start := 0; start < len(lines); {
+		if lines[start].op == ' ' {
+			start++
+			continue
+		}
+
+		// extend the hunk until the next change is too far away to share its context
+		end := start
+		for i := start; i < len(lines); i++ {
+			if lines[i].op != ' ' {
+				end = i + 1
+			} else if i-end >= 2*diffContext {
+				break
+			}
+		}
+
+		from, to := max(start-diffContext, 0), min(end+diffContext, len(lines))
+
+		fromLine, toLine := 1, 1
+		for _, line := range lines[:from] {
+			if line.op != '+' {
+				fromLine++
+			}
+			if line.op != '-' {
+				toLine++
+			}
+		}
+		fromCount, toCount := 0, 0
+		for _, line := range lines[from:to] {
+			if line.op != '+' {
+				fromCount++
+			}
+			if line.op != '-' {
+				toCount++
+			}
+		}
+
+		fmt.Fprintf(&sb, "@@ -%d,%d +%d,%d @@\n", fromLine, fromCount, toLine, toCount)
+		for _, line := range lines[from:to] {
+			sb.WriteByte(line.op)
+			sb.WriteString(line.text)
+			sb.WriteByte('\n')
+		}
+
+		start = to
+	}
+
+	return sb.String()
+}
+
+// `diffLines` returns the edit script from x to y based on their longest common subsequence.
+func diffLines(x []string, y []string) []diffLine {
+	lcs := make([][]int, len(x)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(y)+1)
+	}
+	for i := len(x) - 1; i >= 0; i-- {
+		for j := len(y) - 1; j >= 0; j-- {
+			if x[i] == y[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var lines []diffLine
+	i, j := 0, 0
+	for i < len(x) || j < len(y) {
+		switch {
+		case i < len(x) && j < len(y) && x[i] == y[j]:
+			lines = append(lines, diffLine{' ', x[i]})
+			i++
+			j++
+		case i < len(x) && (j == len(y) || lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, diffLine{'-', x[i]})
+			i++
+		default:
+			lines = append(lines, diffLine{'+', y[j]})
+			j++
+		}
+	}
+	return lines
+}
+
+// `splitLines` splits text into lines, without a trailing empty line for the final newline.
+func splitLines(text string) []string {
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
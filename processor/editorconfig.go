@@ -0,0 +1,286 @@
+package processor
+
+import (
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// maxGlobRange bounds the number of integers a `{num1..num2}` range of a section glob may match.
+const maxGlobRange = 1000
+
+var globRangeRegexp = regexp.MustCompile(`^([+-]?\d+)\.\.([+-]?\d+)$`)
+
+// `ApplyEditorConfig` returns syntaxOptions with the parser and printer options set by the sections of
+// configs matching filepath, except for those named in Overrides.
+//
+// configs are ordered from the outermost directory to the innermost one, so later properties take
+// precedence, and a config with `root = true` drops the ones before it. The supported properties are
+// those read by shfmt: `indent_style`, `indent_size`, `shell_variant`, `binary_next_line`,
+// `switch_case_indent`, `space_redirects` and `function_next_line`, along with `end_of_line`. Unknown
+// properties and values are ignored, as the EditorConfig specification requires. The options set are
+// added to Overrides, so that a Preset does not replace them.
+func ApplyEditorConfig(syntaxOptions SyntaxOptions, filepath string, configs []EditorConfig) SyntaxOptions {
+	properties := editorConfigProperties(filepath, configs)
+	overrides := slices.Clip(syntaxOptions.Overrides)
+
+	// apply returns whether value is valid and has been applied.
+	set := func(name string, property string, apply func(value string) bool) {
+		if value, ok := properties[property]; ok && !slices.Contains(syntaxOptions.Overrides, name) && apply(value) {
+			overrides = append(overrides, name)
+		}
+	}
+	setBool := func(name string, property string, option *bool) {
+		set(name, property, func(value string) bool {
+			b, err := strconv.ParseBool(value)
+			if err == nil {
+				*option = b
+			}
+			return err == nil
+		})
+	}
+
+	set("indent", "indent_style", func(style string) bool {
+		size, err := strconv.ParseUint(properties["indent_size"], 10, 0)
+		if err != nil {
+			size, err = strconv.ParseUint(properties["tab_width"], 10, 0)
+		}
+
+		switch {
+		case style == "tab":
+			syntaxOptions.Indent = 0
+		case style != "space":
+		case err == nil:
+			syntaxOptions.Indent = uint(size)
+		case syntaxOptions.Indent == 0:
+			syntaxOptions.Indent = 2
+		}
+		return style == "tab" || style == "space"
+	})
+	if _, ok := properties["indent_style"]; !ok && syntaxOptions.Indent > 0 {
+		set("indent", "indent_size", func(value string) bool {
+			size, err := strconv.ParseUint(value, 10, 0)
+			if err == nil {
+				syntaxOptions.Indent = uint(size)
+			}
+			return err == nil
+		})
+	}
+
+	set("variant", "shell_variant", func(value string) bool {
+		var variant syntax.LangVariant
+		if variant.Set(value) != nil || variant == syntax.LangAuto {
+			return false
+		}
+		syntaxOptions.Variant = variant
+		return true
+	})
+
+	setBool("binaryNextLine", "binary_next_line", &syntaxOptions.BinaryNextLine)
+	setBool("switchCaseIndent", "switch_case_indent", &syntaxOptions.SwitchCaseIndent)
+	setBool("spaceRedirects", "space_redirects", &syntaxOptions.SpaceRedirects)
+	setBool("functionNextLine", "function_next_line", &syntaxOptions.FunctionNextLine)
+
+	set("lineEnding", "end_of_line", func(value string) bool {
+		switch value {
+		case "lf":
+			syntaxOptions.LineEnding = LineEndingLF
+		case "crlf":
+			syntaxOptions.LineEnding = LineEndingCRLF
+		default:
+			return false
+		}
+		return true
+	})
+
+	syntaxOptions.Overrides = overrides
+
+	return syntaxOptions
+}
+
+// `editorConfigProperties` returns the properties of the sections of configs matching filepath, with
+// lowercase names and values.
+func editorConfigProperties(filepath string, configs []EditorConfig) map[string]string {
+	properties := map[string]string{}
+
+	for _, config := range configs {
+		name := filepath
+		if config.Dir != "" {
+			dir := strings.TrimSuffix(config.Dir, "/") + "/"
+			if !strings.HasPrefix(filepath, dir) {
+				continue
+			}
+			name = filepath[len(dir):]
+		}
+		name = strings.TrimPrefix(path.Clean(name), "/")
+
+		preamble, matches := true, false
+
+		for line := range strings.SplitSeq(config.Text, "\n") {
+			line = strings.TrimSpace(line)
+
+			switch {
+			case line == "" || line[0] == '#' || line[0] == ';':
+			case line[0] == '[' && line[len(line)-1] == ']':
+				glob, err := editorConfigGlob(line[1 : len(line)-1])
+				preamble, matches = false, err == nil && glob.MatchString(name)
+			default:
+				key, value, ok := strings.Cut(line, "=")
+				if !ok {
+					break
+				}
+				key = strings.ToLower(strings.TrimSpace(key))
+				value = strings.ToLower(strings.TrimSpace(value))
+
+				if matches {
+					if value == "unset" {
+						delete(properties, key)
+					} else {
+						properties[key] = value
+					}
+				} else if preamble && key == "root" && value == "true" {
+					clear(properties)
+				}
+			}
+		}
+	}
+
+	return properties
+}
+
+// `editorConfigGlob` compiles the glob of an EditorConfig section into a regular expression matching
+// the paths relative to the directory of the config. A glob without any `/` matches file names in any
+// directory.
+func editorConfigGlob(glob string) (*regexp.Regexp, error) {
+	if !strings.Contains(glob, "/") {
+		glob = "**/" + glob
+	}
+	glob = strings.TrimPrefix(glob, "/")
+
+	return regexp.Compile("^" + globRegexp(glob) + "$")
+}
+
+// `globRegexp` converts an EditorConfig glob into the body of a regular expression.
+func globRegexp(glob string) string {
+	var sb strings.Builder
+
+	for i := 0; i < len(glob); i++ {
+		switch c := glob[i]; c {
+		case '\\':
+			if i+1 < len(glob) {
+				i++
+				sb.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+			} else {
+				sb.WriteString(`\\`)
+			}
+		case '*':
+			switch {
+			case strings.HasPrefix(glob[i:], "**/"):
+				sb.WriteString("(?:.*/)?")
+				i += 2
+			case strings.HasPrefix(glob[i:], "**"):
+				sb.WriteString(".*")
+				i++
+			default:
+				sb.WriteString("[^/]*")
+			}
+		case '?':
+			sb.WriteString("[^/]")
+		case '[':
+			end := strings.IndexByte(glob[i+1:], ']')
+			if end < 0 || strings.Contains(glob[i+1:i+1+end], "/") {
+				sb.WriteString(`\[`)
+				break
+			}
+			class := glob[i+1 : i+1+end]
+			sb.WriteByte('[')
+			if strings.HasPrefix(class, "!") {
+				sb.WriteByte('^')
+				class = class[1:]
+			}
+			sb.WriteString(strings.ReplaceAll(class, `\`, `\\`))
+			sb.WriteByte(']')
+			i += end + 1
+		case '{':
+			end := matchingBrace(glob, i)
+			if end < 0 {
+				sb.WriteString(`\{`)
+				break
+			}
+			sb.WriteString(braceRegexp(glob[i+1 : end]))
+			i = end
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	return sb.String()
+}
+
+// `braceRegexp` converts the contents of a `{...}` group of a glob, either a numeric range or a list of
+// alternatives, into a regular expression. Anything else matches the group literally.
+func braceRegexp(contents string) string {
+	if m := globRangeRegexp.FindStringSubmatch(contents); m != nil {
+		from, _ := strconv.Atoi(m[1])
+		to, _ := strconv.Atoi(m[2])
+		if from > to {
+			from, to = to, from
+		}
+		if to-from < maxGlobRange {
+			numbers := make([]string, 0, to-from+1)
+			for n := from; n <= to; n++ {
+				numbers = append(numbers, strconv.Itoa(n))
+			}
+			return "(?:" + strings.Join(numbers, "|") + ")"
+		}
+	}
+
+	var alternatives []string
+	depth, start := 0, 0
+	for i := 0; i < len(contents); i++ {
+		switch contents[i] {
+		case '\\':
+			i++
+		case '{':
+			depth++
+		case '}':
+			depth--
+		case ',':
+			if depth == 0 {
+				alternatives = append(alternatives, globRegexp(contents[start:i]))
+				start = i + 1
+			}
+		}
+	}
+
+	if alternatives == nil {
+		return regexp.QuoteMeta("{" + contents + "}")
+	}
+
+	alternatives = append(alternatives, globRegexp(contents[start:]))
+
+	return "(?:" + strings.Join(alternatives, "|") + ")"
+}
+
+// `matchingBrace` returns the index of the `}` closing the `{` at index start of glob, or -1.
+func matchingBrace(glob string, start int) int {
+	depth := 0
+	for i := start; i < len(glob); i++ {
+		switch glob[i] {
+		case '\\':
+			i++
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
@@ -0,0 +1,110 @@
+package processor
+
+import (
+	"slices"
+	"sort"
+	"strings"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// shellVariables are the variables which the bash manual lists as used by the shell, rather than taken
+// from the environment. The ones the user sets for the shell and its commands, such as `HOME`, `PATH`,
+// `LANG`, `LC_ALL` or `TMPDIR`, are left out, as scripts expect them from the environment.
+var shellVariables = map[string]bool{}
+
+func init() {
+	for _, name := range strings.Fields(`_ BASH BASHOPTS BASHPID BASH_ALIASES BASH_ARGC BASH_ARGV BASH_ARGV0
+		BASH_CMDS BASH_COMMAND BASH_COMPAT BASH_EXECUTION_STRING BASH_LINENO BASH_LOADABLES_PATH
+		BASH_MONOSECONDS BASH_REMATCH BASH_SOURCE BASH_SUBSHELL BASH_TRAPSIG BASH_VERSINFO BASH_VERSION
+		BASH_XTRACEFD CHILD_MAX COLUMNS COMP_CWORD COMP_KEY COMP_LINE COMP_POINT COMP_TYPE COMP_WORDBREAKS
+		COMP_WORDS COMPREPLY COPROC DIRSTACK EMACS EPOCHREALTIME EPOCHSECONDS EUID EXECIGNORE FCEDIT FIGNORE
+		FUNCNAME FUNCNEST GLOBIGNORE GLOBSORT GROUPS histchars HISTCMD HISTCONTROL HISTFILE HISTFILESIZE
+		HISTIGNORE HISTSIZE HISTTIMEFORMAT HOSTFILE HOSTNAME HOSTTYPE IFS IGNOREEOF INPUTRC INSIDE_EMACS
+		LINENO LINES MACHTYPE MAILCHECK MAPFILE OLDPWD OPTARG OPTERR OPTIND OSTYPE PIPESTATUS PPID
+		PROMPT_COMMAND PROMPT_DIRTRIM PS0 PS1 PS2 PS3 PS4 PWD RANDOM READLINE_ARGUMENT READLINE_LINE
+		READLINE_MARK READLINE_POINT REPLY SECONDS SHELL SHELLOPTS SHLVL SRANDOM TIMEFORMAT TMOUT UID`) {
+		shellVariables[name] = true
+	}
+}
+
+// `Environment` parses originalText and returns the variables it reads without ever assigning them,
+// which it expects to be given by its environment, ordered by their first read. Each comes with the
+// ranges of its name where it is read, along with the operator of the expansions giving it a default
+// value, such as `${FOO:-x}`, or requiring it to be set, such as `${FOO:?}`. A variable has a default if
+// every read of it does, or follows one assigning it a default value, such as `${FOO:=x}`.
+//
+// The variables assigned anywhere in the script, those only removed by `unset`, and the ones set by the
+// shell itself such as `PWD` or `RANDOM` are left out. A variable read in a function before it is
+// declared with `local` is still expected from the environment, as approximated by buildSymbols. Every
+// position points into originalText as it is, as with Print.
+func Environment(originalText string, filepath string, parserOptions ParserOptions) ([]EnvironmentVariable, error) {
+	text, original := normalizeLineEndings(originalText)
+
+	file, err := Parse(text, filepath, parserOptions)
+
+	if err != nil {
+		return nil, original.err(err)
+	}
+
+	expansions := map[syntax.Pos]*syntax.ParamExp{}
+
+	syntax.Walk(file, func(node syntax.Node) bool {
+		if pe, ok := node.(*syntax.ParamExp); ok && pe.Param != nil {
+			expansions[pe.Param.Pos()] = pe
+		}
+		return true
+	})
+
+	variables := []EnvironmentVariable{}
+
+	for _, sym := range buildSymbols(file).symbols {
+		if sym.kind != symbolVariable || shellVariables[sym.name] || slices.ContainsFunc(sym.occurrences, func(o occurrence) bool { return o.definition }) {
+			continue
+		}
+
+		variable := EnvironmentVariable{Name: sym.name, Default: true, Reads: []EnvironmentRead{}}
+		// assigned is set once an expansion such as `${FOO:=x}` assigns the default value to the variable
+		assigned := false
+
+		for _, o := range sym.occurrences {
+			if o.kind == "unset" {
+				continue
+			}
+
+			read := EnvironmentRead{Kind: o.kind, Pos: original.pos(mapPos(o.pos)), End: original.pos(mapPos(o.end))}
+
+			if pe := expansions[o.pos]; pe != nil && pe.Exp != nil && !pe.Excl && !pe.Length {
+				switch pe.Exp.Op {
+				case syntax.DefaultUnset, syntax.DefaultUnsetOrNull, syntax.AssignUnset, syntax.AssignUnsetOrNull:
+					read.Operator = pe.Exp.Op.String()
+					if pe.Exp.Word != nil {
+						read.Default = text[pe.Exp.Word.Pos().Offset():pe.Exp.Word.End().Offset()]
+					}
+				case syntax.ErrorUnset, syntax.ErrorUnsetOrNull:
+					read.Operator = pe.Exp.Op.String()
+					variable.Required = true
+				}
+			}
+
+			switch {
+			case strings.HasSuffix(read.Operator, "="):
+				assigned = true
+			case !assigned && (read.Operator == "" || strings.HasSuffix(read.Operator, "?")):
+				variable.Default = false
+			}
+
+			variable.Reads = append(variable.Reads, read)
+		}
+
+		if len(variable.Reads) > 0 {
+			variables = append(variables, variable)
+		}
+	}
+
+	sort.SliceStable(variables, func(i, j int) bool {
+		return variables[i].Reads[0].Pos.Offset < variables[j].Reads[0].Pos.Offset
+	})
+
+	return variables, nil
+}
@@ -0,0 +1,100 @@
+package processor
+
+import (
+	"strings"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// unquotedExpansionRule reports the parameter expansions and command substitutions left unquoted in
+// the arguments of commands, the words of redirects and the items of `for` and `select` loops, where
+// the shell splits their result into fields and expands globs in them, as in `rm -rf $dir/*`.
+//
+// Assignments, here-strings and the operands of `[[ ]]` and `case` are neither split nor expanded, so
+// they are not checked, nor is anything with Zsh, which does not split unquoted expansions by default.
+var unquotedExpansionRule = Rule{
+	ID:       "unquoted-expansion",
+	Severity: SeverityWarning,
+	Check: func(l *Linter, node syntax.Node) {
+		if l.Variant == syntax.LangZsh {
+			return
+		}
+
+		var words []*syntax.Word
+
+		switch node := node.(type) {
+		case *syntax.CallExpr:
+			words = node.Args
+		case *syntax.Redirect:
+			if node.Op != syntax.WordHdoc && node.Word != nil {
+				words = append(words, node.Word)
+			}
+		case *syntax.ForClause:
+			if wi, ok := node.Loop.(*syntax.WordIter); ok {
+				words = wi.Items
+			}
+		}
+
+		for _, word := range words {
+			for _, part := range unquotedExpansions(word) {
+				l.Report(part.Pos(), part.End(), "quote this expansion to prevent word splitting and globbing", quoteFix(l.Text, part))
+			}
+		}
+	},
+}
+
+// `unquotedExpansions` returns the parameter expansions and command substitutions of word which are
+// not within quotes, except for those which always expand to a single number, such as `$#` or `${#a}`.
+func unquotedExpansions(word *syntax.Word) []syntax.WordPart {
+	var parts []syntax.WordPart
+
+	for _, part := range word.Parts {
+		switch part := part.(type) {
+		case *syntax.ParamExp:
+			if !numericParamExp(part) {
+				parts = append(parts, part)
+			}
+		case *syntax.CmdSubst:
+			parts = append(parts, part)
+		}
+	}
+
+	return parts
+}
+
+// `numericParamExp` reports whether pe always expands to a number: the length of a parameter, or one of
+// the special parameters `$#`, `$?`, `$$` and `$!`.
+func numericParamExp(pe *syntax.ParamExp) bool {
+	if pe.Length && pe.Exp == nil && pe.Slice == nil && pe.Repl == nil {
+		return true
+	}
+	if pe.Param == nil || pe.Excl || pe.Index != nil || pe.Exp != nil || pe.Slice != nil || pe.Repl != nil || pe.Names != 0 {
+		return false
+	}
+
+	switch pe.Param.Value {
+	case "#", "?", "$", "!":
+		return true
+	}
+
+	return false
+}
+
+// `quoteFix` returns the fix putting part of text in double quotes, or nil for a backquoted command
+// substitution with quotes or backslashes, which would need escaping within double quotes.
+func quoteFix(text string, part syntax.WordPart) *Fix {
+	pos, end := part.Pos(), part.End()
+	oldText := text[pos.Offset():end.Offset()]
+
+	if cs, ok := part.(*syntax.CmdSubst); ok && cs.Backquotes && strings.ContainsAny(oldText, `"\`) {
+		return nil
+	}
+
+	return &Fix{
+		Message: "quote the expansion",
+		OldText: oldText,
+		NewText: `"` + oldText + `"`,
+		Pos:     mapPos(pos),
+		End:     mapPos(end),
+	}
+}
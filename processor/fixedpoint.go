@@ -0,0 +1,67 @@
+package processor
+
+import "fmt"
+
+// maxPasses bounds the number of times printFixedPoint prints a script before giving up.
+const maxPasses = 10
+
+// `printFixedPoint` prints originalText, then prints its own output again until it no longer changes.
+//
+// The report is the one of the first pass, with Passes set to the number of passes which changed the
+// text, so 1 for an idempotent input. Otherwise Diff holds the differences between the outputs of the
+// first and second passes, and the source map, if any, is carried through every pass so that it
+// still points into the final text. An error is returned if no fixed point is reached after maxPasses.
+func printFixedPoint(originalText string, filepath string, syntaxOptions SyntaxOptions) (string, Report, error) {
+	text, report, err := printOnce(originalText, filepath, syntaxOptions)
+
+	if err != nil {
+		return "", report, err
+	}
+
+	report.Passes = 1
+
+	for {
+		next, nextReport, err := printOnce(text, filepath, syntaxOptions)
+
+		if err != nil {
+			return "", report, err
+		}
+
+		if next == text {
+			return text, report, nil
+		}
+
+		if report.Passes == 1 {
+			report.Diff = unifiedDiff(text, next, "pass 1", "pass 2")
+		}
+
+		if syntaxOptions.SourceMap {
+			report.SourceMap = composeSourceMaps(report.SourceMap, nextReport.SourceMap)
+		}
+
+		if report.Passes == maxPasses {
+			return "", report, fmt.Errorf("printing did not reach a fixed point after %d passes", maxPasses)
+		}
+
+		report.Passes++
+		text = next
+	}
+}
+
+// `composeSourceMaps` chains first, from the original text to an intermediate one, with second, from
+// that intermediate text to the final one. Nodes missing from second are dropped.
+func composeSourceMaps(first []Mapping, second []Mapping) []Mapping {
+	generated := make(map[Node]Node, len(second))
+	for _, mapping := range second {
+		generated[mapping.Original] = mapping.Generated
+	}
+
+	mappings := []Mapping{}
+	for _, mapping := range first {
+		if node, ok := generated[mapping.Generated]; ok {
+			mappings = append(mappings, Mapping{Original: mapping.Original, Generated: node})
+		}
+	}
+
+	return mappings
+}
@@ -0,0 +1,97 @@
+package processor
+
+import (
+	"fmt"
+	"strings"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// `FunctionStyle` is the form function declarations are printed in.
+type FunctionStyle int
+
+const (
+	// FunctionStyleKeep prints function declarations as they were written.
+	FunctionStyleKeep FunctionStyle = iota
+	// FunctionStylePOSIX prints function declarations as `foo() {`.
+	FunctionStylePOSIX
+	// FunctionStyleKeyword prints function declarations as `function foo {`.
+	FunctionStyleKeyword
+	// FunctionStyleKeywordParens prints function declarations as `function foo() {`.
+	FunctionStyleKeywordParens
+)
+
+func (s FunctionStyle) String() string {
+	switch s {
+	case FunctionStylePOSIX:
+		return "posix"
+	case FunctionStyleKeyword:
+		return "keyword"
+	case FunctionStyleKeywordParens:
+		return "keyword-parens"
+	}
+	return "keep"
+}
+
+// `functionStyleFixes` rewrites the function declarations of file in place into style, returning a
+// fix for each declaration rewritten.
+//
+// The `function` keyword does not exist in POSIX shells, so the styles using it are refused with an
+// error for that variant. Declarations which cannot be written in style are left as they are: a
+// subshell body needs the parentheses, so that they are not read as the ones of the declaration, and
+// anonymous and multi-name Zsh functions are kept as is.
+func functionStyleFixes(file *syntax.File, originalText string, style FunctionStyle, variant syntax.LangVariant) ([]Fix, error) {
+	fixes := []Fix{}
+
+	if style == FunctionStyleKeep {
+		return fixes, nil
+	}
+
+	rsrvWord, parens := style != FunctionStylePOSIX, style != FunctionStyleKeyword
+
+	if rsrvWord && variant == syntax.LangPOSIX {
+		return fixes, fmt.Errorf("function style %s cannot be used with variant %s", style, variant)
+	}
+
+	syntax.Walk(file, func(node syntax.Node) bool {
+		fd, ok := node.(*syntax.FuncDecl)
+		if !ok || fd.Name == nil || (fd.RsrvWord == rsrvWord && fd.Parens == parens) {
+			return true
+		}
+		if _, ok := fd.Body.Cmd.(*syntax.Subshell); ok && !parens {
+			return true
+		}
+
+		pos, end := fd.Pos(), fd.Name.End()
+		if fd.Parens {
+			offset := end.Offset() + uint(strings.IndexByte(originalText[end.Offset():], ')')) + 1
+			end = syntax.NewPos(offset, end.Line(), end.Col()+offset-end.Offset())
+		}
+
+		fd.RsrvWord, fd.Parens = rsrvWord, parens
+
+		fixes = append(fixes, Fix{
+			Message: fmt.Sprintf("use the %s function declaration style", style),
+			OldText: originalText[pos.Offset():end.Offset()],
+			NewText: functionHeader(fd),
+			Pos:     mapPos(pos),
+			End:     mapPos(end),
+		})
+
+		return true
+	})
+
+	return fixes, nil
+}
+
+// `functionHeader` returns the text syntax.Printer writes for fd before its body.
+func functionHeader(fd *syntax.FuncDecl) string {
+	header := fd.Name.Value
+	if fd.RsrvWord {
+		header = "function " + header
+	}
+	if fd.Parens {
+		header += "()"
+	}
+	return header
+}
@@ -0,0 +1,311 @@
+package processor
+
+import (
+	"path"
+	"slices"
+	"strings"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// `Includes` parses originalText and the files it includes with `source` or `.`, recursively, and
+// returns the include graph of the script: every file reached from filepath with the includes it
+// makes, the includes which cannot be resolved, and the cycles of files including each other.
+//
+// As the scripts cannot be read from the file system, the included files are looked up by path in
+// files, which maps their paths to their content. A relative literal path is resolved against the
+// directory of filepath, the script being run, assumed to be the working directory, as are paths based
+// on the directory of `$0`, such as `"$(dirname "$0")/lib.sh"`, since `$0` stays the path of that script
+// in the files it includes. Paths based on `$BASH_SOURCE`, such as `"${BASH_SOURCE%/*}/lib.sh"`, are
+// resolved against the directory of the file including them instead. Other paths, such as those with
+// variables, and the paths missing from files are unresolved.
+//
+// An error is returned if originalText cannot be parsed, while the parse error of an included file is
+// reported as its Message. Every position points into the text of the file it is in, as with Print.
+func Includes(originalText string, filepath string, files map[string]string, parserOptions ParserOptions) (IncludeGraph, error) {
+	graph := IncludeGraph{Files: []IncludeFile{}, Unresolved: []Include{}}
+
+	texts := map[string]string{}
+	for filepath, text := range files {
+		texts[path.Clean(filepath)] = text
+	}
+
+	root := cleanPath(filepath)
+	texts[root] = originalText
+
+	index := map[string]int{}
+	queue := []string{root}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		if _, ok := index[current]; ok {
+			continue
+		}
+
+		includes, err := fileIncludes(texts[current], current, root, parserOptions)
+
+		if err != nil && current == root {
+			return IncludeGraph{}, err
+		}
+
+		file := IncludeFile{Path: current, Includes: includes}
+		if err != nil {
+			file.Message = err.Error()
+		}
+
+		for i := range includes {
+			include := &includes[i]
+			if _, ok := texts[include.To]; !ok && include.To != "" {
+				include.To, include.Message = "", "file not found"
+			}
+			if include.To == "" {
+				graph.Unresolved = append(graph.Unresolved, *include)
+			} else {
+				queue = append(queue, include.To)
+			}
+		}
+
+		index[current] = len(graph.Files)
+		graph.Files = append(graph.Files, file)
+	}
+
+	graph.Cycles = includeCycles(graph.Files, index)
+
+	return graph, nil
+}
+
+// `fileIncludes` parses the text of the file at filepath and returns the `source` and `.` commands it
+// runs when root is the script being run, with the path of the file they include, or an empty one and
+// a message if it is not known.
+func fileIncludes(originalText string, filepath string, root string, parserOptions ParserOptions) ([]Include, error) {
+	text, original := normalizeLineEndings(originalText)
+
+	file, err := Parse(text, filepath, parserOptions)
+
+	if err != nil {
+		return []Include{}, original.err(err)
+	}
+
+	includes, targets := collectIncludes(file, text, original, filepath)
+
+	for i, target := range targets {
+		if target.path != "" {
+			includes[i].To = target.resolve(filepath, root)
+		}
+	}
+
+	return includes, nil
+}
+
+// `includeTarget` is the path of an included file before it is resolved.
+type includeTarget struct {
+	path string
+	// fromFile is set for a path relative to the directory of the file including it, rather than to
+	// the directory of the script being run.
+	fromFile bool
+}
+
+// `resolve` returns the path of target, included by the file at filepath when root is the script run.
+func (target includeTarget) resolve(filepath string, root string) string {
+	switch {
+	case path.IsAbs(target.path):
+		return path.Clean(target.path)
+	case target.fromFile:
+		return path.Join(path.Dir(filepath), target.path)
+	}
+	return path.Join(path.Dir(root), target.path)
+}
+
+// `collectIncludes` returns the `source` and `.` commands run by file, the syntax tree of text normalized
+// into original, as fileIncludes does but without their To path, along with the target of each of them,
+// whose path is empty if it is not known.
+func collectIncludes(file *syntax.File, text string, original lineEndings, filepath string) ([]Include, []includeTarget) {
+	includes := []Include{}
+	targets := []includeTarget{}
+
+	syntax.Walk(file, func(node syntax.Node) bool {
+		call, ok := node.(*syntax.CallExpr)
+		if !ok || len(call.Args) < 2 {
+			return true
+		}
+
+		if name, _ := literalWord(call.Args[0]); name != "source" && name != "." {
+			return true
+		}
+
+		word := call.Args[1]
+		include := Include{
+			From: filepath,
+			Text: text[word.Pos().Offset():word.End().Offset()],
+			Pos:  original.pos(mapPos(word.Pos())),
+			End:  original.pos(mapPos(word.End())),
+		}
+
+		target, ok := includePath(word)
+		if !ok {
+			include.Message = "path is not a literal"
+		} else if target.path == "" {
+			include.Message = "path is empty"
+		}
+
+		includes = append(includes, include)
+		targets = append(targets, target)
+
+		return true
+	})
+
+	return includes, targets
+}
+
+// `includePath` returns the path given by word if it is literal, or based on the directory of the script
+// and literal otherwise, as in `"$(dirname "$0")/lib.sh"`, relative to that directory.
+func includePath(word *syntax.Word) (includeTarget, bool) {
+	if target, ok := literalWord(word); ok {
+		return includeTarget{path: target}, true
+	}
+
+	parts := word.Parts
+	if len(parts) == 1 {
+		if dq, ok := parts[0].(*syntax.DblQuoted); ok && !dq.Dollar {
+			parts = dq.Parts
+		}
+	}
+
+	if len(parts) < 2 {
+		return includeTarget{}, false
+	}
+
+	param := scriptDir(parts[0])
+	if param == "" {
+		return includeTarget{}, false
+	}
+
+	rest, ok := literalWord(&syntax.Word{Parts: parts[1:]})
+	if !ok || !strings.HasPrefix(rest, "/") {
+		return includeTarget{}, false
+	}
+
+	return includeTarget{path: strings.TrimLeft(rest, "/"), fromFile: param == "BASH_SOURCE"}, true
+}
+
+// `scriptDir` returns the parameter holding the path of the script if part expands to its directory:
+// `0` for `$(dirname "$0")` or `${0%/*}`, and `BASH_SOURCE` for the same with `$BASH_SOURCE` or
+// `${BASH_SOURCE[0]}` instead of `$0`. An empty string is returned for any other part.
+func scriptDir(part syntax.WordPart) string {
+	switch part := part.(type) {
+	case *syntax.DblQuoted:
+		if len(part.Parts) != 1 || part.Dollar {
+			return ""
+		}
+		return scriptDir(part.Parts[0])
+	case *syntax.ParamExp:
+		if !scriptPath(part, true) {
+			return ""
+		}
+		return part.Param.Value
+	case *syntax.CmdSubst:
+		if len(part.Stmts) != 1 {
+			return ""
+		}
+		call, ok := part.Stmts[0].Cmd.(*syntax.CallExpr)
+		if !ok || len(call.Args) != 2 || len(call.Assigns) > 0 || call.Args[0].Lit() != "dirname" {
+			return ""
+		}
+		parts := call.Args[1].Parts
+		if len(parts) == 1 {
+			if dq, ok := parts[0].(*syntax.DblQuoted); ok && !dq.Dollar && len(dq.Parts) == 1 {
+				parts = dq.Parts
+			}
+		}
+		pe, ok := parts[0].(*syntax.ParamExp)
+		if len(parts) != 1 || !ok || !scriptPath(pe, false) {
+			return ""
+		}
+		return pe.Param.Value
+	}
+	return ""
+}
+
+// `scriptPath` reports whether pe expands to the path of the script, or to its directory with the suffix
+// `/*` removed if dir is set.
+func scriptPath(pe *syntax.ParamExp, dir bool) bool {
+	if pe.Param == nil || pe.Excl || pe.Length || pe.Slice != nil || pe.Repl != nil || pe.Names != 0 {
+		return false
+	}
+
+	switch pe.Param.Value {
+	case "0":
+		if pe.Index != nil {
+			return false
+		}
+	case "BASH_SOURCE":
+		if pe.Index != nil {
+			word, ok := pe.Index.(*syntax.Word)
+			if !ok || word.Lit() != "0" {
+				return false
+			}
+		}
+	default:
+		return false
+	}
+
+	if !dir {
+		return pe.Exp == nil
+	}
+
+	return pe.Exp != nil && pe.Exp.Op == syntax.RemSmallSuffix && pe.Exp.Word != nil && pe.Exp.Word.Lit() == "/*"
+}
+
+// `includeCycles` returns the cycles of files including each other, each as the paths of its files in
+// the order they include each other, starting with the first one reached from the root file.
+func includeCycles(files []IncludeFile, index map[string]int) [][]string {
+	cycles := [][]string{}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := make([]int, len(files))
+	var stack []string
+
+	var visit func(i int)
+	visit = func(i int) {
+		state[i] = visiting
+		stack = append(stack, files[i].Path)
+
+		for _, include := range files[i].Includes {
+			j, ok := index[include.To]
+			if !ok {
+				continue
+			}
+			switch state[j] {
+			case unvisited:
+				visit(j)
+			case visiting:
+				start := slices.Index(stack, include.To)
+				cycles = append(cycles, slices.Clone(stack[start:]))
+			}
+		}
+
+		stack = stack[:len(stack)-1]
+		state[i] = visited
+	}
+
+	if len(files) > 0 {
+		visit(0)
+	}
+
+	return cycles
+}
+
+// `cleanPath` returns filepath cleaned as the paths files are included by, or an empty path as it is.
+func cleanPath(filepath string) string {
+	if filepath == "" {
+		return ""
+	}
+	return path.Clean(filepath)
+}
@@ -0,0 +1,241 @@
+package processor
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// `layout` applies the layout printer options which syntax.Printer does not support to text, the
+// output of printing file, whose original source is originalText.
+//
+// AlignAssignments and AlignComments only insert blanks within lines, and MaxBlankLines only inserts
+// blank lines, so each of them is applied in turn on the lines of text, using the syntax tree of text
+// to tell where statements and comments are. The text is returned as is if it cannot be parsed.
+// A MaxBlankLines of 0 or 1 keeps the single blank line left by the printer, as does the printer
+// itself, so 0 stands for the default rather than for removing every blank line.
+func layout(file *syntax.File, originalText string, text string, filepath string, syntaxOptions SyntaxOptions) string {
+	if text == "" {
+		return text
+	}
+
+	formatted, err := Parse(text, filepath, syntaxOptions.ParserOptions)
+
+	if err != nil {
+		return text
+	}
+
+	lines := splitLines(text)
+
+	if syntaxOptions.AlignAssignments {
+		alignAssignments(formatted, lines)
+	}
+
+	if syntaxOptions.AlignComments {
+		alignComments(formatted, lines)
+	}
+
+	if syntaxOptions.MaxBlankLines > 1 {
+		lines = keepBlankLines(file, splitLines(originalText), formatted, lines, syntaxOptions.MaxBlankLines)
+	}
+
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// `alignAssignments` right-aligns the variable names of lone declarations, such as `local a=1`, on
+// consecutive lines with the same indentation and keyword, so that their `=` line up. Blanks are
+// inserted between the keyword and the names, as a shell would not accept any around `=`. Plain
+// assignments such as `a=1` are left alone, as the only place left for blanks would be before the
+// name, which would indent the line as if it were nested.
+func alignAssignments(formatted *syntax.File, lines []string) {
+	var assigns []*syntax.Assign
+
+	syntax.Walk(formatted, func(node syntax.Node) bool {
+		if stmt, ok := node.(*syntax.Stmt); ok && startsLine(lines, stmt) && stmt.Pos().Line() == stmt.End().Line() {
+			if assign := loneAssign(stmt); assign != nil {
+				assigns = append(assigns, assign)
+			}
+		}
+		return true
+	})
+
+	sort.Slice(assigns, func(i, j int) bool {
+		return assigns[i].Pos().Offset() < assigns[j].Pos().Offset()
+	})
+
+	prefix := func(assign *syntax.Assign) string {
+		return lines[assign.Pos().Line()-1][:assign.Name.Pos().Col()-1]
+	}
+
+	for start := 0; start < len(assigns); {
+		end := start + 1
+		for end < len(assigns) && assigns[end].Pos().Line() == assigns[end-1].Pos().Line()+1 &&
+			prefix(assigns[end]) == prefix(assigns[start]) {
+			end++
+		}
+
+		var column uint
+		for _, assign := range assigns[start:end] {
+			column = max(column, equalsColumn(lines, assign))
+		}
+		for _, assign := range assigns[start:end] {
+			line, name := assign.Pos().Line()-1, assign.Name.Pos().Col()-1
+			lines[line] = lines[line][:name] + strings.Repeat(" ", int(column-equalsColumn(lines, assign))) + lines[line][name:]
+		}
+
+		start = end
+	}
+}
+
+// `loneAssign` returns the assignment of stmt if it consists of a declaration clause of a single plain
+// variable, without any redirect or array index.
+func loneAssign(stmt *syntax.Stmt) *syntax.Assign {
+	if stmt.Negated || stmt.Background || stmt.Coprocess || len(stmt.Redirs) > 0 {
+		return nil
+	}
+
+	decl, ok := stmt.Cmd.(*syntax.DeclClause)
+	if !ok || len(decl.Args) != 1 {
+		return nil
+	}
+
+	assign := decl.Args[0]
+	if assign.Naked || assign.Name == nil || assign.Index != nil {
+		return nil
+	}
+
+	return assign
+}
+
+// `equalsColumn` returns the display column of the `=` of assign.
+func equalsColumn(lines []string, assign *syntax.Assign) uint {
+	end := assign.Name.End().Col() - 1
+	if assign.Append {
+		end++
+	}
+	return displayWidth(lines[assign.Pos().Line()-1][:end])
+}
+
+// `alignComments` aligns the trailing comments of consecutive lines into the column right after the
+// longest of their lines. Comments always run to the end of their line, so they are found from there,
+// as blanks may have been inserted before them by alignAssignments.
+func alignComments(formatted *syntax.File, lines []string) {
+	_, comments := flattenNodes(formatted)
+
+	split := func(comment *syntax.Comment) (string, string) {
+		line := lines[comment.Pos().Line()-1]
+		start := len(line) - len(comment.Text) - 1
+		return strings.TrimRight(line[:start], " \t"), line[start:]
+	}
+
+	var trailing []*syntax.Comment
+	for _, comment := range comments {
+		if code, _ := split(comment); code != "" {
+			trailing = append(trailing, comment)
+		}
+	}
+
+	for start := 0; start < len(trailing); {
+		end := start + 1
+		for end < len(trailing) && trailing[end].Pos().Line() == trailing[end-1].Pos().Line()+1 {
+			end++
+		}
+
+		if end-start > 1 {
+			var column uint
+			for _, comment := range trailing[start:end] {
+				code, _ := split(comment)
+				column = max(column, displayWidth(code)+1)
+			}
+			for _, comment := range trailing[start:end] {
+				code, text := split(comment)
+				lines[comment.Pos().Line()-1] = code + strings.Repeat(" ", int(column-displayWidth(code))) + text
+			}
+		}
+
+		start = end
+	}
+}
+
+// `keepBlankLines` restores up to maxBlankLines of the blank lines which preceded each statement and
+// comment in originalLines, where the printer only kept one.
+//
+// Statements and comments are paired between file and formatted as sourceMap does, and only those
+// starting their line in both texts are considered, so blank lines within a multi-line string or a
+// heredoc body are never counted.
+func keepBlankLines(file *syntax.File, originalLines []string, formatted *syntax.File, lines []string, maxBlankLines uint) []string {
+	original, originalComments := flattenNodes(file)
+	generated, generatedComments := flattenNodes(formatted)
+
+	var pairs [][2]syntax.Node
+
+	for i := 0; i < len(original) && i < len(generated); i++ {
+		if reflect.TypeOf(original[i]) != reflect.TypeOf(generated[i]) {
+			break
+		}
+		if _, ok := original[i].(*syntax.Stmt); ok {
+			pairs = append(pairs, [2]syntax.Node{original[i], generated[i]})
+		}
+	}
+
+	if len(originalComments) == len(generatedComments) {
+		for i, comment := range originalComments {
+			if comment.Text == generatedComments[i].Text {
+				pairs = append(pairs, [2]syntax.Node{comment, generatedComments[i]})
+			}
+		}
+	}
+
+	// the number of blank lines to add before each line of lines
+	extra := map[uint]uint{}
+
+	for _, pair := range pairs {
+		o, g := pair[0], pair[1]
+		if !validPos(o.Pos()) || !startsLine(originalLines, o) || !startsLine(lines, g) {
+			continue
+		}
+
+		if blankLinesBefore(lines, g.Pos().Line()) != 1 {
+			continue
+		}
+
+		if blanks := min(blankLinesBefore(originalLines, o.Pos().Line()), maxBlankLines); blanks > 1 {
+			extra[g.Pos().Line()] = blanks - 1
+		}
+	}
+
+	if len(extra) == 0 {
+		return lines
+	}
+
+	result := make([]string, 0, len(lines))
+	for i, line := range lines {
+		for range extra[uint(i)+1] {
+			result = append(result, "")
+		}
+		result = append(result, line)
+	}
+
+	return result
+}
+
+// `startsLine` reports whether node is the first thing on its line of lines.
+func startsLine(lines []string, node syntax.Node) bool {
+	pos := node.Pos()
+	if pos.Line() == 0 || pos.Line() > uint(len(lines)) {
+		return false
+	}
+	line := lines[pos.Line()-1]
+	return pos.Col()-1 <= uint(len(line)) && strings.TrimSpace(line[:pos.Col()-1]) == ""
+}
+
+// `blankLinesBefore` returns the number of blank lines right before the 1-based line of lines.
+func blankLinesBefore(lines []string, line uint) uint {
+	var count uint
+	for i := int(line) - 2; i >= 0 && strings.TrimSpace(lines[i]) == ""; i-- {
+		count++
+	}
+	return count
+}
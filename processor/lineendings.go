@@ -0,0 +1,128 @@
+package processor
+
+import (
+	"strings"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// `LineEnding` is the style of line breaks of the formatted script.
+type LineEnding int
+
+const (
+	// LineEndingAuto keeps the style of the first line break of the original text, or LF if it has none.
+	LineEndingAuto LineEnding = iota
+	LineEndingLF
+	LineEndingCRLF
+)
+
+func (l LineEnding) String() string {
+	switch l {
+	case LineEndingLF:
+		return "lf"
+	case LineEndingCRLF:
+		return "crlf"
+	}
+	return "auto"
+}
+
+// byteOrderMark is the UTF-8 encoding of U+FEFF, which some editors write at the start of a file.
+const byteOrderMark = "\ufeff"
+
+// `lineEndings` records what normalizeLineEndings removed from a text, so that positions in the
+// normalized text can be moved back to the text as it was.
+type lineEndings struct {
+	bom   bool
+	style LineEnding
+	// crs holds, for every line, the number of CRLF line breaks before it.
+	crs []uint
+}
+
+// `normalizeLineEndings` strips the byte-order mark of text and turns its CRLF line breaks into LF ones.
+//
+// The parser already drops the carriage return of CRLF line breaks, but not the byte-order mark, which
+// ends up in the first word of the script, and every node ending a line would otherwise span it.
+func normalizeLineEndings(text string) (string, lineEndings) {
+	endings := lineEndings{style: LineEndingLF}
+
+	if strings.HasPrefix(text, byteOrderMark) {
+		endings.bom = true
+		text = text[len(byteOrderMark):]
+	}
+
+	if i := strings.IndexByte(text, '\n'); i > 0 && text[i-1] == '\r' {
+		endings.style = LineEndingCRLF
+	}
+
+	if !strings.Contains(text, "\r\n") {
+		return text, endings
+	}
+
+	var sb strings.Builder
+	sb.Grow(len(text))
+
+	var crs uint
+	endings.crs = append(endings.crs, 0)
+
+	for line := range strings.SplitAfterSeq(text, "\n") {
+		if strings.HasSuffix(line, "\r\n") {
+			sb.WriteString(line[:len(line)-2])
+			sb.WriteByte('\n')
+			crs++
+		} else {
+			sb.WriteString(line)
+		}
+		endings.crs = append(endings.crs, crs)
+	}
+
+	return sb.String(), endings
+}
+
+// `restoreLineEndings` writes the line breaks of text, which only has LF ones, in the given style and
+// adds a byte-order mark if bom is set.
+func restoreLineEndings(text string, bom bool, style LineEnding) string {
+	if style == LineEndingCRLF {
+		text = strings.ReplaceAll(text, "\n", "\r\n")
+	}
+
+	if bom {
+		text = byteOrderMark + text
+	}
+
+	return text
+}
+
+// `pos` moves a position in the normalized text to the same position in the text as it was.
+func (l lineEndings) pos(pos Pos) Pos {
+	if pos.Line == 0 {
+		return pos
+	}
+
+	if l.bom {
+		pos.Offset += uint(len(byteOrderMark))
+
+		if pos.Line == 1 {
+			pos.Col += uint(len(byteOrderMark))
+		}
+	}
+
+	if len(l.crs) > 0 {
+		pos.Offset += l.crs[min(pos.Line, uint(len(l.crs)))-1]
+	}
+
+	return pos
+}
+
+// `err` moves the positions of the errors returned for the normalized text to the text as it was.
+func (l lineEndings) err(err error) error {
+	switch e := err.(type) {
+	case syntax.ParseError:
+		pos := l.pos(mapPos(e.Pos))
+		e.Pos = syntax.NewPos(pos.Offset, pos.Line, pos.Col)
+		return e
+	case VerifyError:
+		e.Original = l.pos(e.Original)
+		return e
+	}
+	return err
+}
@@ -0,0 +1,191 @@
+package processor
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// `Severity` is how serious the problems reported by a lint rule are.
+type Severity int
+
+const (
+	SeverityError Severity = iota
+	SeverityWarning
+	SeverityInfo
+	SeverityStyle
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityWarning:
+		return "warning"
+	case SeverityInfo:
+		return "info"
+	case SeverityStyle:
+		return "style"
+	}
+	return "error"
+}
+
+// `Rule` is a lint rule, checking the nodes of a script for one kind of problem.
+type Rule struct {
+	// ID names the rule in diagnostics and in LintOptions.Rules, such as `backquote`.
+	ID string
+	// Severity is the severity of the diagnostics of the rule, unless LintOptions.Rules sets another one.
+	Severity Severity
+	// Check is called with every node of the script, in the order of syntax.Walk, and reports the
+	// problems it finds with l.
+	Check func(l *Linter, node syntax.Node)
+}
+
+// rules are the rules run by Lint, in the order their diagnostics are reported for the same position.
+var rules = []Rule{
+	backquoteRule,
+	unquotedExpansionRule,
+}
+
+// `RegisterRule` adds rule to the rules run by Lint, replacing the one with the same ID if any.
+func RegisterRule(rule Rule) {
+	if i := slices.IndexFunc(rules, func(r Rule) bool { return r.ID == rule.ID }); i >= 0 {
+		rules[i] = rule
+	} else {
+		rules = append(rules, rule)
+	}
+}
+
+type LintOptions struct {
+	ParserOptions
+	// PrinterOptions are used to print the replacement text of fixes.
+	PrinterOptions
+	// Rules configures the rules by ID: `off` disables a rule, `on` enables it, and a severity such as
+	// `warning` enables it with that severity. Rules which are not configured are enabled.
+	Rules map[string]string
+}
+
+// `Linter` holds the script being linted and the diagnostics reported by the Check func of rules.
+type Linter struct {
+	File    *syntax.File
+	Text    string
+	Variant syntax.LangVariant
+
+	printer     *syntax.Printer
+	rule        Rule
+	severity    Severity
+	diagnostics []Diagnostic
+}
+
+// `Report` reports a problem of the rule being run between pos and end, with an optional fix.
+func (l *Linter) Report(pos, end syntax.Pos, message string, fix *Fix) {
+	l.diagnostics = append(l.diagnostics, Diagnostic{
+		Rule:     l.rule.ID,
+		Severity: l.severity.String(),
+		Message:  message,
+		Pos:      mapPos(pos),
+		End:      mapPos(end),
+		Fix:      fix,
+	})
+}
+
+// `Lint` parses originalText and runs the rules enabled by lintOptions on every node of its syntax
+// tree, returning the diagnostics they report ordered by position.
+// The byte-order mark and CRLF line breaks are normalized away before parsing as Print does, and every
+// position points into originalText as it is. An error is returned if the script cannot be parsed, or
+// if Rules names an unknown rule or severity.
+func Lint(originalText string, filepath string, lintOptions LintOptions) ([]Diagnostic, error) {
+	enabled, err := enabledRules(lintOptions.Rules)
+
+	if err != nil {
+		return nil, err
+	}
+
+	text, original := normalizeLineEndings(originalText)
+
+	file, err := Parse(text, filepath, lintOptions.ParserOptions)
+
+	if err != nil {
+		return nil, original.err(err)
+	}
+
+	l := &Linter{
+		File:    file,
+		Text:    text,
+		Variant: lintOptions.Variant,
+		printer: newPrinter(lintOptions.PrinterOptions),
+	}
+
+	diagnostics := []Diagnostic{}
+
+	for _, rule := range enabled {
+		l.rule, l.severity = rule.rule, rule.severity
+
+		syntax.Walk(file, func(node syntax.Node) bool {
+			if node != nil {
+				rule.rule.Check(l, node)
+			}
+			return true
+		})
+
+		diagnostics = append(diagnostics, l.diagnostics...)
+		l.diagnostics = l.diagnostics[:0]
+	}
+
+	sort.SliceStable(diagnostics, func(i, j int) bool {
+		return diagnostics[i].Pos.Offset < diagnostics[j].Pos.Offset
+	})
+
+	for i := range diagnostics {
+		d := &diagnostics[i]
+		d.Pos, d.End = original.pos(d.Pos), original.pos(d.End)
+
+		if d.Fix != nil {
+			fix := *d.Fix
+			fix.Pos, fix.End = original.pos(fix.Pos), original.pos(fix.End)
+			d.Fix = &fix
+		}
+	}
+
+	return diagnostics, nil
+}
+
+type enabledRule struct {
+	rule     Rule
+	severity Severity
+}
+
+// `enabledRules` returns the rules enabled by config, with their severity.
+func enabledRules(config map[string]string) ([]enabledRule, error) {
+	for id := range config {
+		if !slices.ContainsFunc(rules, func(r Rule) bool { return r.ID == id }) {
+			return nil, fmt.Errorf("unknown lint rule %q", id)
+		}
+	}
+
+	var enabled []enabledRule
+
+	for _, rule := range rules {
+		severity := rule.Severity
+
+		switch value := config[rule.ID]; value {
+		case "", "on":
+		case "off":
+			continue
+		default:
+			severity = -1
+			for s := SeverityError; s <= SeverityStyle; s++ {
+				if value == s.String() {
+					severity = s
+				}
+			}
+			if severity < 0 {
+				return nil, fmt.Errorf("invalid severity %q for lint rule %q, expected one of: on, off, error, warning, info, style", value, rule.ID)
+			}
+		}
+
+		enabled = append(enabled, enabledRule{rule: rule, severity: severity})
+	}
+
+	return enabled, nil
+}
@@ -2,7 +2,9 @@ package processor
 
 import (
 	"bytes"
+	"fmt"
 	"io"
+	"sort"
 
 	"mvdan.cc/sh/v3/syntax"
 )
@@ -28,11 +30,40 @@ type PrinterOptions struct {
 	Minify           bool
 	SingleLine       bool
 	FunctionNextLine bool
+	PrintWidth       uint
+	SingleQuote      bool
+	BraceParams      bool
+	PlainAnsiCQuotes bool
+	LineEnding       LineEnding
+	// MaxBlankLines caps the consecutive blank lines kept from the original text, where the printer
+	// keeps at most one. Both 0, the default, and 1 keep the single blank line of the printer, as blank
+	// lines are never removed altogether.
+	MaxBlankLines    uint
+	AlignComments    bool
+	AlignAssignments bool
+	FunctionStyle    FunctionStyle
+	DoubleBrackets   bool
 }
 
 type SyntaxOptions struct {
 	ParserOptions
 	PrinterOptions
+	Simplify   bool
+	SourceMap  bool
+	Verify     bool
+	FixedPoint bool
+	Tolerant   bool
+	// ReportBackquotes reports every backquoted command substitution printed as `$(...)` as a fix.
+	ReportBackquotes bool
+	// CheckComments parses the output again to report the comments lost or moved by the printer.
+	CheckComments bool
+	// Preset names the printer options of a style guide, see ApplyPreset.
+	Preset string
+	// EditorConfigs are the .editorconfig files which apply to the script, see ApplyEditorConfig.
+	EditorConfigs []EditorConfig
+	// Overrides names the options set by the caller, such as `indent`, which neither Preset nor
+	// EditorConfigs change.
+	Overrides []string
 }
 
 // `Parse` converts shell script text into a structured syntax tree.
@@ -41,6 +72,13 @@ type SyntaxOptions struct {
 // The supplied file path is used for contextual error reporting.
 // It returns a syntax.File representing the parsed script, or an error if parsing fails.
 func Parse(text string, filepath string, parserOptions ParserOptions) (*syntax.File, error) {
+	parser = newParser(parserOptions)
+
+	return parser.Parse(bytes.NewReader([]byte(text)), filepath)
+}
+
+// `newParser` creates a syntax.Parser configured from parserOptions.
+func newParser(parserOptions ParserOptions) *syntax.Parser {
 	var options []syntax.ParserOption
 
 	options = append(options, syntax.KeepComments(parserOptions.KeepComments), syntax.Variant(parserOptions.Variant))
@@ -53,42 +91,254 @@ func Parse(text string, filepath string, parserOptions ParserOptions) (*syntax.F
 		options = append(options, syntax.RecoverErrors(parserOptions.RecoverErrors))
 	}
 
-	parser = syntax.NewParser(options...)
-
-	return parser.Parse(bytes.NewReader([]byte(text)), filepath)
+	return syntax.NewParser(options...)
 }
 
 // `Print` returns the formatted shell script defined in originalText.
 // It first parses the input using the parser options in syntaxOptions and then prints the resulting
 // syntax tree using printer options—including indentation, single-line formatting, and others.
+// The properties of the EditorConfigs matching filepath are applied first, then the options of the
+// modeline of the script, then the printer options of the named Preset, if any, except for Overrides
+// and the options set by the EditorConfigs or the modeline, and the resolved parser and printer
+// options are reported.
+// When FixedPoint is set, printing is repeated by printFixedPoint until the output no longer changes.
+// The byte-order mark and CRLF line breaks of the input are detected and normalized away before parsing;
+// the output keeps the byte-order mark and uses the line breaks chosen by LineEnding, and every position
+// in the report or in an error points into originalText and the output as they are.
 // The filepath parameter is used for context in error messages. On success, Print returns the formatted
-// script as a string, or an error if parsing or printing fails.
-func Print(originalText string, filepath string, syntaxOptions SyntaxOptions) (string, error) {
+// script as a string along with a Report of the rewrites applied, or an error if parsing or printing fails.
+func Print(originalText string, filepath string, syntaxOptions SyntaxOptions) (string, Report, error) {
+	var report Report
+	var err error
+
+	if len(syntaxOptions.EditorConfigs) > 0 {
+		syntaxOptions = ApplyEditorConfig(syntaxOptions, filepath, syntaxOptions.EditorConfigs)
+	}
+
+	text, original := normalizeLineEndings(originalText)
+
+	syntaxOptions, modeline, warnings := ApplyModeline(syntaxOptions, text)
+
+	if syntaxOptions.Preset != "" {
+		syntaxOptions.PrinterOptions, err = ApplyPreset(syntaxOptions.PrinterOptions, syntaxOptions.Preset, syntaxOptions.Overrides)
+
+		if err != nil {
+			return "", report, err
+		}
+	}
+
+	if syntaxOptions.FixedPoint {
+		text, report, err = printFixedPoint(text, filepath, syntaxOptions)
+	} else {
+		text, report, err = printOnce(text, filepath, syntaxOptions)
+	}
+
+	report.LineEnding = original.style.String()
+	report.ByteOrderMark = original.bom
+	report.Modeline = modeline
+	report.Warnings = append(warnings, report.Warnings...)
+
+	if syntaxOptions.Preset != "" || len(syntaxOptions.EditorConfigs) > 0 || modeline != "" {
+		report.Preset = syntaxOptions.Preset
+		report.ParserOptions = &syntaxOptions.ParserOptions
+		report.PrinterOptions = &syntaxOptions.PrinterOptions
+	}
+
+	if err != nil {
+		report.move(original.pos, lineEndings{}.pos)
+
+		return "", report, original.err(err)
+	}
+
+	lineEnding := syntaxOptions.LineEnding
+
+	if lineEnding == LineEndingAuto {
+		lineEnding = original.style
+	}
+
+	text = restoreLineEndings(text, original.bom, lineEnding)
+	_, generated := normalizeLineEndings(text)
+
+	report.move(original.pos, generated.pos)
+
+	return text, report, nil
+}
+
+// `printOnce` parses and prints originalText a single time, or hands it over to printTolerant if it
+// cannot be parsed and Tolerant is set.
+// When Simplify is set, the tree is simplified before printing, quotes are normalised by normalizeQuotes
+// as the quote style options require, and when PrintWidth is set, over-long lines are then wrapped by wrapLines.
+// Blank lines beyond the single one kept by the printer and alignment of comments and assignments are
+// then handled by layout.
+// Backquoted command substitutions are always printed as `$(...)`, each one being reported as a fix when
+// ReportBackquotes is set. So is every function declaration rewritten into FunctionStyle by
+// functionStyleFixes, and every `[` or `test` command rewritten into `[[ ]]` by doubleBracketFixes when
+// DoubleBrackets is set.
+// When Verify is set, the formatted script is parsed again and must be equivalent to the original one,
+// or to the printed tree when Simplify or DoubleBrackets rewrite it,
+// and when SourceMap is set, the report also maps the nodes of the original text to the formatted one.
+// The formatted script is parsed again as well when there are fixes, whose NewText is then taken from
+// it by printedFixes, so that it matches the output once lines are wrapped and aligned.
+// When CheckComments is set and the script has any comments, those lost or moved by the printer are
+// reported as warnings.
+// Unless Verify is set, a formatted script which cannot be parsed again is only reported as a warning.
+func printOnce(originalText string, filepath string, syntaxOptions SyntaxOptions) (string, Report, error) {
+	var report Report
+
 	file, err := Parse(originalText, filepath, syntaxOptions.ParserOptions)
 
 	if err != nil {
-		return "", err
+		if _, ok := err.(syntax.ParseError); ok && syntaxOptions.Tolerant {
+			return printTolerant(originalText, filepath, syntaxOptions)
+		}
+
+		return "", report, err
+	}
+
+	if syntaxOptions.Simplify {
+		report.Simplifications = simplify(file, originalText)
+	}
+
+	if syntaxOptions.SingleQuote || syntaxOptions.BraceParams || syntaxOptions.PlainAnsiCQuotes {
+		normalizeQuotes(file, syntaxOptions.PrinterOptions)
+	}
+
+	if syntaxOptions.ReportBackquotes {
+		report.Fixes, err = backquoteFixes(file, originalText, syntaxOptions.PrinterOptions)
+
+		if err != nil {
+			return "", report, err
+		}
+	}
+
+	if syntaxOptions.FunctionStyle != FunctionStyleKeep {
+		fixes, err := functionStyleFixes(file, originalText, syntaxOptions.FunctionStyle, syntaxOptions.Variant)
+
+		if err != nil {
+			return "", report, err
+		}
+
+		report.Fixes = append(report.Fixes, fixes...)
+	}
+
+	if syntaxOptions.DoubleBrackets {
+		fixes, err := doubleBracketFixes(file, originalText, syntaxOptions.PrinterOptions, syntaxOptions.Variant)
+
+		if err != nil {
+			return "", report, err
+		}
+
+		report.Fixes = append(report.Fixes, fixes...)
+	}
+
+	sort.SliceStable(report.Fixes, func(i, j int) bool {
+		return report.Fixes[i].Pos.Offset < report.Fixes[j].Pos.Offset
+	})
+
+	text, err := printFile(file, syntaxOptions.PrinterOptions)
+
+	if err != nil {
+		return "", report, err
+	}
+
+	if syntaxOptions.PrintWidth > 0 {
+		text, err = wrapLines(text, filepath, syntaxOptions)
+
+		if err != nil {
+			return "", report, err
+		}
+	}
+
+	if syntaxOptions.MaxBlankLines > 1 || syntaxOptions.AlignComments || syntaxOptions.AlignAssignments {
+		text = layout(file, originalText, text, filepath, syntaxOptions)
 	}
 
-	printer = syntax.NewPrinter(
-		syntax.Indent(syntaxOptions.Indent),
-		syntax.BinaryNextLine(syntaxOptions.BinaryNextLine),
-		syntax.SwitchCaseIndent(syntaxOptions.SwitchCaseIndent),
-		syntax.SpaceRedirects(syntaxOptions.SpaceRedirects),
-		syntax.KeepPadding(syntaxOptions.KeepPadding),
-		syntax.Minify(syntaxOptions.Minify),
-		syntax.SingleLine(syntaxOptions.SingleLine),
-		syntax.FunctionNextLine(syntaxOptions.FunctionNextLine),
+	comments := 0
+
+	// Minify drops every comment on purpose.
+	if syntaxOptions.CheckComments && !syntaxOptions.Minify {
+		comments = countComments(file)
+	}
+
+	if !syntaxOptions.Verify && !syntaxOptions.SourceMap && comments == 0 && len(report.Fixes) == 0 {
+		return text, report, nil
+	}
+
+	formatted, err := Parse(text, filepath, syntaxOptions.ParserOptions)
+
+	if err != nil {
+		err = fmt.Errorf("formatted script is not valid: %w", err)
+
+		if syntaxOptions.Verify {
+			return "", report, err
+		}
+
+		report.Warnings = append(report.Warnings, Warning{Message: err.Error()})
+
+		return text, report, nil
+	}
+
+	if syntaxOptions.Verify {
+		original := file
+
+		// The rewrites of Simplify and DoubleBrackets are meant to change the syntax tree, so the output
+		// is compared with the rewritten tree instead, which only checks the printer.
+		if !syntaxOptions.Simplify && !syntaxOptions.DoubleBrackets {
+			original, err = Parse(originalText, filepath, syntaxOptions.ParserOptions)
+
+			if err != nil {
+				return "", report, err
+			}
+		}
+
+		err = verify(original, formatted, syntaxOptions.PrinterOptions)
+
+		if err != nil {
+			return "", report, err
+		}
+	}
+
+	if syntaxOptions.SourceMap {
+		report.SourceMap = sourceMap(file, formatted)
+	}
+
+	if len(report.Fixes) > 0 {
+		printedFixes(report.Fixes, file, formatted, text)
+	}
+
+	if comments > 0 {
+		report.Warnings = commentWarnings(file, formatted)
+	}
+
+	return text, report, nil
+}
+
+// `newPrinter` creates a syntax.Printer configured from printerOptions.
+func newPrinter(printerOptions PrinterOptions) *syntax.Printer {
+	return syntax.NewPrinter(
+		syntax.Indent(printerOptions.Indent),
+		syntax.BinaryNextLine(printerOptions.BinaryNextLine),
+		syntax.SwitchCaseIndent(printerOptions.SwitchCaseIndent),
+		syntax.SpaceRedirects(printerOptions.SpaceRedirects),
+		syntax.KeepPadding(printerOptions.KeepPadding),
+		syntax.Minify(printerOptions.Minify),
+		syntax.SingleLine(printerOptions.SingleLine),
+		syntax.FunctionNextLine(printerOptions.FunctionNextLine),
 	)
+}
+
+// `printFile` prints file with a printer configured from printerOptions.
+func printFile(file *syntax.File, printerOptions PrinterOptions) (string, error) {
+	printer = newPrinter(printerOptions)
 
 	var buf bytes.Buffer
 	writer := io.Writer(&buf)
 
-	err = printer.Print(writer, file)
+	err := printer.Print(writer, file)
 
 	if err != nil {
 		return "", err
 	}
 
-	return buf.String(), err
+	return buf.String(), nil
 }
@@ -0,0 +1,203 @@
+package processor
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// modelineLines is the number of lines at the start and at the end of a script searched for a
+// modeline, as vim does.
+const modelineLines = 5
+
+var modelineRegexp = regexp.MustCompile(`(?:^|[ \t])#[ \t]*sh-syntax:`)
+
+// modelineOptions sets each option of a modeline from its value, reporting whether the value is valid.
+var modelineOptions = map[string]func(syntaxOptions *SyntaxOptions, value string) bool{
+	"keepComments": boolOption(func(o *SyntaxOptions) *bool { return &o.KeepComments }),
+	"variant": func(o *SyntaxOptions, value string) bool {
+		var variant syntax.LangVariant
+		if variant.Set(value) != nil || variant == syntax.LangAuto {
+			return false
+		}
+		o.Variant = variant
+		return true
+	},
+	"indent":           uintOption(func(o *SyntaxOptions) *uint { return &o.Indent }),
+	"binaryNextLine":   boolOption(func(o *SyntaxOptions) *bool { return &o.BinaryNextLine }),
+	"switchCaseIndent": boolOption(func(o *SyntaxOptions) *bool { return &o.SwitchCaseIndent }),
+	"spaceRedirects":   boolOption(func(o *SyntaxOptions) *bool { return &o.SpaceRedirects }),
+	"keepPadding":      boolOption(func(o *SyntaxOptions) *bool { return &o.KeepPadding }),
+	"minify":           boolOption(func(o *SyntaxOptions) *bool { return &o.Minify }),
+	"singleLine":       boolOption(func(o *SyntaxOptions) *bool { return &o.SingleLine }),
+	"functionNextLine": boolOption(func(o *SyntaxOptions) *bool { return &o.FunctionNextLine }),
+	"printWidth":       uintOption(func(o *SyntaxOptions) *uint { return &o.PrintWidth }),
+	"singleQuote":      boolOption(func(o *SyntaxOptions) *bool { return &o.SingleQuote }),
+	"braceParams":      boolOption(func(o *SyntaxOptions) *bool { return &o.BraceParams }),
+	"plainAnsiCQuotes": boolOption(func(o *SyntaxOptions) *bool { return &o.PlainAnsiCQuotes }),
+	"lineEnding": func(o *SyntaxOptions, value string) bool {
+		for style := LineEndingAuto; style <= LineEndingCRLF; style++ {
+			if value == style.String() {
+				o.LineEnding = style
+				return true
+			}
+		}
+		return false
+	},
+	"maxBlankLines":    uintOption(func(o *SyntaxOptions) *uint { return &o.MaxBlankLines }),
+	"alignComments":    boolOption(func(o *SyntaxOptions) *bool { return &o.AlignComments }),
+	"alignAssignments": boolOption(func(o *SyntaxOptions) *bool { return &o.AlignAssignments }),
+	"functionStyle": func(o *SyntaxOptions, value string) bool {
+		for style := FunctionStyleKeep; style <= FunctionStyleKeywordParens; style++ {
+			if value == style.String() {
+				o.FunctionStyle = style
+				return true
+			}
+		}
+		return false
+	},
+	"doubleBrackets": boolOption(func(o *SyntaxOptions) *bool { return &o.DoubleBrackets }),
+	"preset": func(o *SyntaxOptions, value string) bool {
+		if _, ok := presets[value]; !ok {
+			return false
+		}
+		o.Preset = value
+		return true
+	},
+}
+
+func boolOption(option func(syntaxOptions *SyntaxOptions) *bool) func(*SyntaxOptions, string) bool {
+	return func(syntaxOptions *SyntaxOptions, value string) bool {
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return false
+		}
+		*option(syntaxOptions) = b
+		return true
+	}
+}
+
+func uintOption(option func(syntaxOptions *SyntaxOptions) *uint) func(*SyntaxOptions, string) bool {
+	return func(syntaxOptions *SyntaxOptions, value string) bool {
+		n, err := strconv.ParseUint(value, 10, 0)
+		if err != nil {
+			return false
+		}
+		*option(syntaxOptions) = uint(n)
+		return true
+	}
+}
+
+// `ApplyModeline` returns syntaxOptions with the options set by the modeline of text, a comment such as
+// `# sh-syntax: indent=4 variant=posix minify=false` within its first or last modelineLines lines, the
+// options of the modeline as written, or an empty string if there is none, and a warning for each of
+// them which is unknown or has an invalid value.
+//
+// Options are separated by blanks and named as in Overrides, and a boolean option without any value is
+// set to true. The script knows best how it should be formatted, so the options of the modeline are
+// added to Overrides, and `preset` replaces Preset, leaving the other options of the modeline as set.
+func ApplyModeline(syntaxOptions SyntaxOptions, text string) (SyntaxOptions, string, []Warning) {
+	options, pos := findModeline(text, syntaxOptions.Variant)
+
+	if options == "" {
+		return syntaxOptions, "", nil
+	}
+
+	var warnings []Warning
+	overrides := slices.Clip(syntaxOptions.Overrides)
+
+	for i := 0; i < len(options); {
+		if options[i] == ' ' || options[i] == '\t' {
+			i++
+			continue
+		}
+
+		end := i + strings.IndexAny(options[i:]+" ", " \t")
+		field := options[i:end]
+
+		name, value, ok := strings.Cut(field, "=")
+		if !ok {
+			value = "true"
+		}
+
+		if set, known := modelineOptions[name]; !known || !set(&syntaxOptions, value) {
+			message := fmt.Sprintf("unknown modeline option %q", name)
+			if known {
+				message = fmt.Sprintf("invalid value %q for modeline option %q", value, name)
+			}
+
+			warnings = append(warnings, Warning{
+				Message: message,
+				Text:    field,
+				Pos:     Pos{Offset: pos.Offset + uint(i), Line: pos.Line, Col: pos.Col + uint(i)},
+				End:     Pos{Offset: pos.Offset + uint(end), Line: pos.Line, Col: pos.Col + uint(end)},
+			})
+		} else if name != "preset" {
+			overrides = append(overrides, name)
+		}
+
+		i = end
+	}
+
+	syntaxOptions.Overrides = overrides
+
+	return syntaxOptions, strings.TrimSpace(options), warnings
+}
+
+// `findModeline` returns the options of the first modeline within the first or last modelineLines
+// lines of text, along with the position they start at, or an empty string if there is none.
+//
+// Only comments are searched, so that a heredoc body or a quoted string which looks like a modeline
+// is not mistaken for one. As the modeline may change the variant of the script, text is parsed with
+// variant first, then with the other variants, and a script which none of them parses has none.
+func findModeline(text string, variant syntax.LangVariant) (string, Pos) {
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	candidate := func(line uint) bool {
+		return line <= modelineLines || line+modelineLines > uint(len(lines))
+	}
+
+	// Most scripts have no modeline, so they are not parsed again.
+	if !slices.ContainsFunc(lines, func(line string) bool { return modelineRegexp.MatchString(line) }) {
+		return "", Pos{}
+	}
+
+	var comments []*syntax.Comment
+
+	for _, v := range []syntax.LangVariant{variant, syntax.LangBash, syntax.LangZsh, syntax.LangMirBSDKorn, syntax.LangPOSIX} {
+		file, err := newParser(ParserOptions{KeepComments: true, Variant: v}).Parse(strings.NewReader(text), "")
+		if err != nil {
+			continue
+		}
+
+		syntax.Walk(file, func(node syntax.Node) bool {
+			if comment, ok := node.(*syntax.Comment); ok && candidate(comment.Pos().Line()) {
+				comments = append(comments, comment)
+			}
+			return true
+		})
+
+		break
+	}
+
+	sort.Slice(comments, func(i, j int) bool {
+		return comments[i].Pos().Offset() < comments[j].Pos().Offset()
+	})
+
+	for _, comment := range comments {
+		if loc := modelineRegexp.FindStringIndex("#" + comment.Text); loc != nil {
+			pos := comment.Pos()
+			return comment.Text[loc[1]-1:], Pos{Offset: pos.Offset() + uint(loc[1]), Line: pos.Line(), Col: pos.Col() + uint(loc[1])}
+		}
+	}
+
+	return "", Pos{}
+}
@@ -0,0 +1,53 @@
+package processor
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// presets are the printer options of popular style guides, by name. Each of them sets Indent,
+// BinaryNextLine, SwitchCaseIndent, SpaceRedirects and FunctionNextLine.
+var presets = map[string]PrinterOptions{
+	// the Google Shell Style Guide, as documented by shfmt: `shfmt -i 2 -ci -bn`
+	"google": {Indent: 2, BinaryNextLine: true, SwitchCaseIndent: true},
+	// the defaults of shfmt, which indents with tabs
+	"shfmt-default": {},
+	// two-space indentation without any of the optional spacing
+	"compact": {Indent: 2},
+}
+
+// `ApplyPreset` returns printerOptions with the options set by the named preset, except for those named
+// in overrides, such as `indent` or `binaryNextLine`, which keep their values from printerOptions.
+func ApplyPreset(printerOptions PrinterOptions, name string, overrides []string) (PrinterOptions, error) {
+	preset, ok := presets[name]
+
+	if !ok {
+		names := make([]string, 0, len(presets))
+		for name := range presets {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		return printerOptions, fmt.Errorf("unknown preset %q, expected one of: %s", name, strings.Join(names, ", "))
+	}
+
+	if !slices.Contains(overrides, "indent") {
+		printerOptions.Indent = preset.Indent
+	}
+	if !slices.Contains(overrides, "binaryNextLine") {
+		printerOptions.BinaryNextLine = preset.BinaryNextLine
+	}
+	if !slices.Contains(overrides, "switchCaseIndent") {
+		printerOptions.SwitchCaseIndent = preset.SwitchCaseIndent
+	}
+	if !slices.Contains(overrides, "spaceRedirects") {
+		printerOptions.SpaceRedirects = preset.SpaceRedirects
+	}
+	if !slices.Contains(overrides, "functionNextLine") {
+		printerOptions.FunctionNextLine = preset.FunctionNextLine
+	}
+
+	return printerOptions, nil
+}
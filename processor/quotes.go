@@ -0,0 +1,140 @@
+package processor
+
+import (
+	"strings"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// `normalizeQuotes` rewrites the quoting of file in place according to the quote style printer options.
+//
+// SingleQuote turns double-quoted strings without any expansion into single-quoted ones, BraceParams
+// adds braces to short parameter expansions such as `$var`, and PlainAnsiCQuotes drops the `$` of
+// `$'...'` strings without any escape sequence. Strings nested in double quotes, in heredoc bodies or
+// in arithmetic expressions are never converted to single quotes, as those are not special there.
+// In an unquoted heredoc body, such as the `"q"` of `${x:-"q"}`, the quotes are even printed as is.
+func normalizeQuotes(file *syntax.File, printerOptions PrinterOptions) {
+	arithmWords := map[*syntax.Word]bool{}
+	heredocs := map[*syntax.Word]bool{}
+	var stack []syntax.Node
+
+	syntax.Walk(file, func(node syntax.Node) bool {
+		if node == nil {
+			stack = stack[:len(stack)-1]
+			return true
+		}
+		stack = append(stack, node)
+
+		switch node := node.(type) {
+		case *syntax.ArithmExp:
+			markArithmWords(arithmWords, node.X)
+		case *syntax.ArithmCmd:
+			markArithmWords(arithmWords, node.X)
+		case *syntax.LetClause:
+			for _, expr := range node.Exprs {
+				markArithmWords(arithmWords, expr)
+			}
+		case *syntax.CStyleLoop:
+			markArithmWords(arithmWords, node.Init)
+			markArithmWords(arithmWords, node.Cond)
+			markArithmWords(arithmWords, node.Post)
+		case *syntax.Redirect:
+			if node.Hdoc != nil {
+				heredocs[node.Hdoc] = true
+			}
+		case *syntax.Assign:
+			markArithmWords(arithmWords, node.Index)
+		case *syntax.ArrayElem:
+			markArithmWords(arithmWords, node.Index)
+		case *syntax.ParamExp:
+			markArithmWords(arithmWords, node.Index)
+			if node.Slice != nil {
+				markArithmWords(arithmWords, node.Slice.Offset)
+				markArithmWords(arithmWords, node.Slice.Length)
+			}
+			if printerOptions.BraceParams && node.Short && node.Dollar.IsValid() {
+				node.Rbrace = node.End()
+				node.Short = false
+			}
+		case *syntax.SglQuoted:
+			if printerOptions.PlainAnsiCQuotes && node.Dollar && !strings.Contains(node.Value, "\\") {
+				node.Dollar = false
+			}
+		case *syntax.Word:
+			if !printerOptions.SingleQuote || arithmWords[node] {
+				break
+			}
+			for _, parent := range stack {
+				if _, ok := parent.(*syntax.DblQuoted); ok {
+					return true
+				}
+				if word, ok := parent.(*syntax.Word); ok && heredocs[word] {
+					return true
+				}
+			}
+			for i, part := range node.Parts {
+				if sq := singleQuoted(part); sq != nil {
+					node.Parts[i] = sq
+				}
+			}
+		}
+		return true
+	})
+}
+
+// `markArithmWords` records every word of an arithmetic expression.
+func markArithmWords(words map[*syntax.Word]bool, expr syntax.ArithmExpr) {
+	if expr == nil {
+		return
+	}
+	syntax.Walk(expr, func(node syntax.Node) bool {
+		if word, ok := node.(*syntax.Word); ok {
+			words[word] = true
+		}
+		return true
+	})
+}
+
+// `singleQuoted` returns the single-quoted equivalent of a double-quoted string with no expansions,
+// or nil if part is anything else or contains a single quote.
+func singleQuoted(part syntax.WordPart) *syntax.SglQuoted {
+	dq, ok := part.(*syntax.DblQuoted)
+	if !ok || dq.Dollar || len(dq.Parts) > 1 {
+		return nil
+	}
+
+	var value string
+	if len(dq.Parts) == 1 {
+		lit, ok := dq.Parts[0].(*syntax.Lit)
+		if !ok {
+			return nil
+		}
+		value = lit.Value
+	}
+
+	if strings.Contains(value, "'") {
+		return nil
+	}
+
+	// Within double quotes, a backslash only escapes `$`, `` ` ``, `"`, `\` and newlines;
+	// it is kept as is before any other character, just like within single quotes.
+	var sb strings.Builder
+	for i := 0; i < len(value); i++ {
+		if value[i] == '\\' && i+1 < len(value) {
+			switch value[i+1] {
+			case '$', '`', '"', '\\':
+				i++
+			case '\n':
+				i++
+				continue
+			}
+		}
+		sb.WriteByte(value[i])
+	}
+
+	return &syntax.SglQuoted{
+		Left:  dq.Left,
+		Right: dq.Right,
+		Value: sb.String(),
+	}
+}
@@ -0,0 +1,188 @@
+package processor
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// functionNameRegexp matches the function names which can be written without quotes in any variant.
+var functionNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.:-]*$`)
+
+// `Rename` parses originalText and returns the edits renaming the variable or function whose name is at
+// offset, a byte offset into originalText, to name: one for every definition and reference of it found
+// by buildSymbols, such as its assignments, `local` declarations, expansions, function declaration and
+// calls. The edits are returned as fixes, with positions pointing into originalText as it is.
+//
+// An error is returned if there is no symbol at offset, if name is not valid, or if it would collide
+// with a symbol of the same kind in the scope of the symbol, in a scope around it or within it, or with
+// a command of the script for a function. The uses of the symbol which cannot be followed statically,
+// such as indirect expansions like `${!ref}`, namerefs, `unset` without `-f` for a function, and words
+// which may name it, are reported as warnings and left as they are.
+func Rename(originalText string, filepath string, parserOptions ParserOptions, offset uint, name string) ([]Fix, []Warning, error) {
+	text, original := normalizeLineEndings(originalText)
+
+	file, err := Parse(text, filepath, parserOptions)
+
+	if err != nil {
+		return nil, nil, original.err(err)
+	}
+
+	sym := buildSymbols(file).at(offset, original)
+
+	if sym == nil {
+		return nil, nil, fmt.Errorf("no variable or function at offset %d", offset)
+	}
+
+	switch {
+	case sym.kind == symbolVariable && !syntax.ValidName(name):
+		return nil, nil, fmt.Errorf("%q is not a valid variable name", name)
+	case sym.kind == symbolFunction && (!functionNameRegexp.MatchString(name) || syntax.IsKeyword(name)):
+		return nil, nil, fmt.Errorf("%q is not a valid function name", name)
+	}
+
+	fixes := []Fix{}
+	warnings := []Warning{}
+
+	if name == sym.name {
+		return fixes, warnings, nil
+	}
+
+	if err := renameCollision(file, sym, name); err != nil {
+		return nil, nil, err
+	}
+
+	for _, o := range sym.occurrences {
+		fixes = append(fixes, Fix{
+			Message: fmt.Sprintf("rename %s %s to %s", sym.kind, sym.name, name),
+			OldText: sym.name,
+			NewText: name,
+			Pos:     original.pos(mapPos(o.pos)),
+			End:     original.pos(mapPos(o.end)),
+		})
+	}
+
+	for _, w := range renameWarnings(file, text, sym) {
+		w.Pos, w.End = original.pos(w.Pos), original.pos(w.End)
+		warnings = append(warnings, w)
+	}
+
+	return fixes, warnings, nil
+}
+
+// `renameCollision` returns an error if renaming sym to name would make its occurrences or those of
+// another symbol resolve differently.
+func renameCollision(file *syntax.File, sym *symbol, name string) error {
+	key := symbolKey{sym.kind, name}
+
+	for s := sym.scope; s != nil; s = s.parent {
+		if _, ok := s.index[key]; ok {
+			return fmt.Errorf("%s %s is already used in the %s scope", sym.kind, name, s.kind)
+		}
+	}
+
+	var within func(s *scope) *scope
+	within = func(s *scope) *scope {
+		for _, child := range s.children {
+			if _, ok := child.index[key]; ok {
+				return child
+			}
+			if found := within(child); found != nil {
+				return found
+			}
+		}
+		return nil
+	}
+
+	if s := within(sym.scope); s != nil {
+		return fmt.Errorf("%s %s is already used in a %s scope within the one of %s", sym.kind, name, s.kind, sym.name)
+	}
+
+	if sym.kind == symbolFunction {
+		var command *syntax.Word
+
+		syntax.Walk(file, func(node syntax.Node) bool {
+			if call, ok := node.(*syntax.CallExpr); ok && command == nil && len(call.Args) > 0 && call.Args[0].Lit() == name {
+				command = call.Args[0]
+			}
+			return command == nil
+		})
+
+		if command != nil {
+			return fmt.Errorf("command %s is already used at %s", name, command.Pos())
+		}
+	}
+
+	return nil
+}
+
+// `renameWarnings` returns a warning for every use of sym which buildSymbols cannot follow: indirect
+// expansions and namerefs for a variable, `unset` without `-f` for a function, and literal words
+// spelling its name elsewhere, such as `declare -p name` or `[[ -v name ]]`.
+func renameWarnings(file *syntax.File, text string, sym *symbol) []Warning {
+	var warnings []Warning
+
+	warn := func(node syntax.Node, message string) {
+		warnings = append(warnings, Warning{
+			Message: message,
+			Text:    text[node.Pos().Offset():node.End().Offset()],
+			Pos:     mapPos(node.Pos()),
+			End:     mapPos(node.End()),
+		})
+	}
+
+	occurs := func(node syntax.Node) bool {
+		for _, o := range sym.occurrences {
+			if o.pos == node.Pos() {
+				return true
+			}
+		}
+		return false
+	}
+
+	syntax.Walk(file, func(node syntax.Node) bool {
+		switch node := node.(type) {
+		case *syntax.ParamExp:
+			if sym.kind == symbolVariable && node.Excl && node.Names == 0 && node.Index == nil {
+				warn(node, fmt.Sprintf("indirect expansion may refer to %s by name", sym.name))
+			}
+		case *syntax.DeclClause:
+			if sym.kind != symbolVariable {
+				break
+			}
+			for _, assign := range node.Args {
+				if assign.Name == nil && assign.Value != nil && strings.HasPrefix(assign.Value.Lit(), "-") && strings.Contains(assign.Value.Lit(), "n") {
+					warn(node, fmt.Sprintf("nameref may refer to %s by name", sym.name))
+					break
+				}
+			}
+		case *syntax.CallExpr:
+			if len(node.Args) == 0 {
+				break
+			}
+			unset := node.Args[0].Lit() == "unset"
+			for _, arg := range node.Args[1:] {
+				if arg.Lit() == "-f" || arg.Lit() == "-v" {
+					unset = false
+				}
+				if arg.Lit() != sym.name || occurs(arg) {
+					continue
+				}
+				if unset && sym.kind == symbolFunction {
+					warn(arg, fmt.Sprintf("unset without -f may remove function %s when no variable has its name", sym.name))
+				} else {
+					warn(arg, fmt.Sprintf("argument may refer to %s %s by name", sym.kind, sym.name))
+				}
+			}
+		case *syntax.UnaryTest:
+			if word, ok := node.X.(*syntax.Word); ok && node.Op == syntax.TsVarSet && sym.kind == symbolVariable && word.Lit() == sym.name {
+				warn(word, fmt.Sprintf("test may refer to %s by name", sym.name))
+			}
+		}
+		return true
+	})
+
+	return warnings
+}
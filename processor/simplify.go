@@ -0,0 +1,57 @@
+package processor
+
+import (
+	"sort"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// `simplify` applies syntax.Simplify to file and reports every node it changed.
+//
+// syntax.Simplify only tells whether anything changed at all, so it is run on one node at a time,
+// from the innermost nodes outwards. By the time a node is simplified its children already are,
+// which attributes each change to the node it was made in. The reported positions and text are
+// taken from originalText before the node is modified, and the result is sorted by position.
+func simplify(file *syntax.File, originalText string) []Simplification {
+	var nodes []syntax.Node
+
+	syntax.Walk(file, func(node syntax.Node) bool {
+		switch node.(type) {
+		case *syntax.Assign, *syntax.ParamExp,
+			*syntax.ArithmExp, *syntax.ArithmCmd, *syntax.ParenArithm, *syntax.BinaryArithm,
+			*syntax.CmdSubst, *syntax.Subshell, *syntax.Word,
+			*syntax.TestClause, *syntax.ParenTest, *syntax.BinaryTest, *syntax.UnaryTest:
+			nodes = append(nodes, node)
+		}
+		return true
+	})
+
+	simplifications := []Simplification{}
+
+	for i := len(nodes) - 1; i >= 0; i-- {
+		node := nodes[i]
+		pos, end := node.Pos(), node.End()
+
+		if !syntax.Simplify(node) {
+			continue
+		}
+
+		var text string
+		if pos.IsValid() && end.IsValid() && end.Offset() <= uint(len(originalText)) {
+			text = originalText[pos.Offset():end.Offset()]
+		}
+
+		simplifications = append(simplifications, Simplification{
+			Node: nodeName(node),
+			Text: text,
+			Pos:  mapPos(pos),
+			End:  mapPos(end),
+		})
+	}
+
+	sort.SliceStable(simplifications, func(i, j int) bool {
+		return simplifications[i].Pos.Offset < simplifications[j].Pos.Offset
+	})
+
+	return simplifications
+}
@@ -0,0 +1,121 @@
+package processor
+
+import (
+	"reflect"
+	"sort"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// `sourceMap` maps the nodes of file, the tree which was printed, to the same nodes in formatted, the
+// syntax tree of the formatted output.
+//
+// Both trees are walked in parallel; nodes are paired in walk order for as long as their types match,
+// so a rewrite of the tree never pairs unrelated nodes. Comments can be moved around by the printer,
+// so they are paired on their own, by order and text. Nodes without a valid position in the original
+// source, such as the ones created by rewrites, are left out.
+func sourceMap(file *syntax.File, formatted *syntax.File) []Mapping {
+	original, originalComments := flattenNodes(file)
+	generated, generatedComments := flattenNodes(formatted)
+
+	mappings := []Mapping{}
+
+	add := func(o, g syntax.Node) {
+		if !validPos(o.Pos()) || !validPos(o.End()) || !validPos(g.Pos()) || !validPos(g.End()) {
+			return
+		}
+		mapping := Mapping{Original: *mapNode(o), Generated: *mapNode(g)}
+		if size := len(mappings); size > 0 && mappings[size-1] == mapping {
+			return
+		}
+		mappings = append(mappings, mapping)
+	}
+
+	for _, pair := range pairNodes(original, generated) {
+		add(pair[0], pair[1])
+	}
+
+	if len(originalComments) == len(generatedComments) {
+		for i, comment := range originalComments {
+			if comment.Text == generatedComments[i].Text {
+				add(comment, generatedComments[i])
+			}
+		}
+	}
+
+	sort.SliceStable(mappings, func(i, j int) bool {
+		return mappings[i].Original.Pos.Offset < mappings[j].Original.Pos.Offset
+	})
+
+	return mappings
+}
+
+// `pairNodes` pairs the nodes of original and generated, as listed by flattenNodes, for as long as
+// their types match.
+func pairNodes(original []syntax.Node, generated []syntax.Node) [][2]syntax.Node {
+	var pairs [][2]syntax.Node
+
+	for i := 0; i < len(original) && i < len(generated); i++ {
+		if reflect.TypeOf(original[i]) != reflect.TypeOf(generated[i]) {
+			break
+		}
+		pairs = append(pairs, [2]syntax.Node{original[i], generated[i]})
+	}
+
+	return pairs
+}
+
+// `printedFixes` sets the NewText of every fix to the text its node was printed as in text, the final
+// output whose syntax tree is formatted, as wrapLines and layout may have changed it since the fix was
+// made. Nodes are paired between file and formatted as sourceMap does, and the fixes whose range is
+// not the one of a paired node, such as the ones of function headers, are left as they are.
+func printedFixes(fixes []Fix, file *syntax.File, formatted *syntax.File, text string) {
+	original, _ := flattenNodes(file)
+	generated, _ := flattenNodes(formatted)
+
+	printed := map[[2]uint]string{}
+
+	for _, pair := range pairNodes(original, generated) {
+		o, g := pair[0], pair[1]
+		if !validPos(o.Pos()) || !validPos(o.End()) || !validPos(g.Pos()) || !validPos(g.End()) {
+			continue
+		}
+		key := [2]uint{o.Pos().Offset(), o.End().Offset()}
+		if _, ok := printed[key]; !ok {
+			printed[key] = text[g.Pos().Offset():g.End().Offset()]
+		}
+	}
+
+	for i, fix := range fixes {
+		if newText, ok := printed[[2]uint{fix.Pos.Offset, fix.End.Offset}]; ok {
+			fixes[i].NewText = newText
+		}
+	}
+}
+
+// `flattenNodes` lists the nodes of file in walk order, with comments listed separately.
+func flattenNodes(file *syntax.File) ([]syntax.Node, []*syntax.Comment) {
+	var nodes []syntax.Node
+	var comments []*syntax.Comment
+
+	syntax.Walk(file, func(node syntax.Node) bool {
+		switch node := node.(type) {
+		case nil, *syntax.File:
+		case *syntax.Comment:
+			comments = append(comments, node)
+		default:
+			nodes = append(nodes, node)
+		}
+		return true
+	})
+
+	sort.SliceStable(comments, func(i, j int) bool {
+		return comments[i].Pos().Offset() < comments[j].Pos().Offset()
+	})
+
+	return nodes, comments
+}
+
+func validPos(pos syntax.Pos) bool {
+	return pos.IsValid() && !pos.IsRecovered()
+}
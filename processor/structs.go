@@ -73,11 +73,191 @@ type ParseError struct {
 	Pos Pos
 }
 
+// `Simplification` is a node rewritten by the simplify pass, with its text and position in the original source.
+type Simplification struct {
+	Node string
+	Text string
+	Pos  Pos
+	End  Pos
+}
+
+// `Fix` is a rewrite of the range between Pos and End of the original source, from OldText to NewText.
+type Fix struct {
+	Message string
+	OldText string
+	NewText string
+	Pos     Pos
+	End     Pos
+}
+
+// `Warning` is an issue found while printing which did not prevent it, such as a lost comment.
+type Warning struct {
+	Message string
+	Text    string
+	Pos     Pos
+	End     Pos
+}
+
+// `Diagnostic` is a problem reported by a lint rule between Pos and End, along with a fix for it if
+// the rule has one.
+type Diagnostic struct {
+	Rule     string
+	Severity string
+	Message  string
+	Pos      Pos
+	End      Pos
+	Fix      *Fix
+}
+
+// `Occurrence` is the range of the name of a symbol where it is defined or referenced, such as by an
+// `assign`, a `local` declaration or an `expansion`.
+type Occurrence struct {
+	Kind string
+	Pos  Pos
+	End  Pos
+}
+
+// `Symbol` is a variable or function of a scope, with the occurrences of its name.
+type Symbol struct {
+	Name        string
+	Kind        string
+	Definitions []Occurrence
+	References  []Occurrence
+}
+
+// `Scope` is the file, a function body, a subshell or a command substitution, with its symbols and the
+// scopes within it.
+type Scope struct {
+	Kind     string
+	Name     string
+	Pos      Pos
+	End      Pos
+	Symbols  []Symbol
+	Children []Scope
+}
+
+// `Command` is a command run by a script, with the ranges of its name where it is run.
+type Command struct {
+	Name  string
+	Count int
+	Calls []Node
+}
+
+// `CommandInventory` is the commands run by a script, see Commands.
+type CommandInventory struct {
+	Builtins  []Command
+	Functions []Command
+	External  []Command
+}
+
+// `EnvironmentRead` is the range of the name of an environment variable where it is read, along with the
+// operator of the expansion providing a default value for it, such as `:-`, with that value, or
+// requiring it to be set, such as `:?`.
+type EnvironmentRead struct {
+	Kind     string
+	Operator string
+	Default  string
+	Pos      Pos
+	End      Pos
+}
+
+// `EnvironmentVariable` is a variable a script reads without assigning it, see Environment. Default is
+// set if every read of it provides a default value or follows one assigning it, and Required if one of
+// them fails when it is unset.
+type EnvironmentVariable struct {
+	Name     string
+	Default  bool
+	Required bool
+	Reads    []EnvironmentRead
+}
+
+// `Include` is a `source` or `.` command of the file From, between Pos and End, including the file To.
+// To is empty if the included file is not known, with a Message telling why.
+type Include struct {
+	From    string
+	To      string
+	Text    string
+	Message string
+	Pos     Pos
+	End     Pos
+}
+
+// `IncludeFile` is a file of an include graph, with the includes it makes. Message is the error parsing
+// it, if any.
+type IncludeFile struct {
+	Path     string
+	Message  string
+	Includes []Include
+}
+
+// `IncludeGraph` is the graph of the files a script includes, see Includes.
+type IncludeGraph struct {
+	Files      []IncludeFile
+	Unresolved []Include
+	Cycles     [][]string
+}
+
+// `Location` is the range of the name of a symbol in the document at Path, where it is defined or
+// referenced.
+type Location struct {
+	Path string
+	Kind string
+	Pos  Pos
+	End  Pos
+}
+
+// `WorkspaceSymbol` is a variable or function of a workspace, with the occurrences of its name in every
+// document sharing it.
+type WorkspaceSymbol struct {
+	Name        string
+	Kind        string
+	Definitions []Location
+	References  []Location
+}
+
+// `Mapping` maps the range of a node in the original source to its range in the formatted output.
+type Mapping struct {
+	Original  Node
+	Generated Node
+}
+
+// `EditorConfig` is the text of an .editorconfig file, along with the directory containing it.
+type EditorConfig struct {
+	Dir  string `json:"dir"`
+	Text string `json:"text"`
+}
+
+// `Report` collects what Print did to the source besides formatting it.
+type Report struct {
+	Simplifications []Simplification `json:"simplifications"`
+	Fixes           []Fix            `json:"fixes"`
+	SourceMap       []Mapping        `json:"sourceMap"`
+	Passes          int              `json:"passes"`
+	Diff            string           `json:"diff"`
+	Warnings        []Warning        `json:"warnings"`
+	ParseErrors     []ParseError     `json:"parseErrors"`
+	LineEnding      string           `json:"lineEnding"`
+	ByteOrderMark   bool             `json:"byteOrderMark"`
+	Preset          string           `json:"preset"`
+	Modeline        string           `json:"modeline"`
+	ParserOptions   *ParserOptions   `json:"parserOptions"`
+	PrinterOptions  *PrinterOptions  `json:"printerOptions"`
+}
+
 type Result struct {
 	File        `json:"file"`
 	Text        string `json:"text"`
 	*ParseError `json:"parseError"`
 	Message     string `json:"message"`
+	Report
+	Diagnostics []Diagnostic          `json:"diagnostics"`
+	Symbols     *Scope                `json:"symbols"`
+	Symbol      *Symbol               `json:"symbol"`
+	Commands    *CommandInventory     `json:"commands"`
+	Environment []EnvironmentVariable `json:"environment"`
+	Includes    *IncludeGraph         `json:"includes"`
+	Workspace   *WorkspaceSymbol      `json:"workspaceSymbol"`
+	Unused      []WorkspaceSymbol     `json:"unusedFunctions"`
 }
 
 func MapParseError(err error) (*ParseError, string) {
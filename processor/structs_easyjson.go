@@ -6,6 +6,7 @@ import (
 	json "encoding/json"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
+	syntax "mvdan.cc/sh/v3/syntax"
 )
 
 // suppress unused package warning
@@ -15,7 +16,7 @@ var (
 	_ *jwriter.Writer
 )
 
-func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor(in *jlexer.Lexer, out *Word) {
+func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor(in *jlexer.Lexer, out *WorkspaceSymbol) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -29,50 +30,71 @@ func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor(in *jlexer.Lexer, out
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "Parts":
+		case "Name":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Name = string(in.String())
+			}
+		case "Kind":
 			if in.IsNull() {
 				in.Skip()
-				out.Parts = nil
+			} else {
+				out.Kind = string(in.String())
+			}
+		case "Definitions":
+			if in.IsNull() {
+				in.Skip()
+				out.Definitions = nil
 			} else {
 				in.Delim('[')
-				if out.Parts == nil {
+				if out.Definitions == nil {
 					if !in.IsDelim(']') {
-						out.Parts = make([]Node, 0, 1)
+						out.Definitions = make([]Location, 0, 0)
 					} else {
-						out.Parts = []Node{}
+						out.Definitions = []Location{}
 					}
 				} else {
-					out.Parts = (out.Parts)[:0]
+					out.Definitions = (out.Definitions)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v1 Node
+					var v1 Location
 					if in.IsNull() {
 						in.Skip()
 					} else {
 						(v1).UnmarshalEasyJSON(in)
 					}
-					out.Parts = append(out.Parts, v1)
+					out.Definitions = append(out.Definitions, v1)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "Lit":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Lit = string(in.String())
-			}
-		case "Pos":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				(out.Pos).UnmarshalEasyJSON(in)
-			}
-		case "End":
+		case "References":
 			if in.IsNull() {
 				in.Skip()
+				out.References = nil
 			} else {
-				(out.End).UnmarshalEasyJSON(in)
+				in.Delim('[')
+				if out.References == nil {
+					if !in.IsDelim(']') {
+						out.References = make([]Location, 0, 0)
+					} else {
+						out.References = []Location{}
+					}
+				} else {
+					out.References = (out.References)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 Location
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v2).UnmarshalEasyJSON(in)
+					}
+					out.References = append(out.References, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -84,68 +106,79 @@ func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor(out *jwriter.Writer, in Word) {
+func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor(out *jwriter.Writer, in WorkspaceSymbol) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Parts\":"
+		const prefix string = ",\"Name\":"
 		out.RawString(prefix[1:])
-		if in.Parts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"Kind\":"
+		out.RawString(prefix)
+		out.String(string(in.Kind))
+	}
+	{
+		const prefix string = ",\"Definitions\":"
+		out.RawString(prefix)
+		if in.Definitions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v2, v3 := range in.Parts {
-				if v2 > 0 {
+			for v3, v4 := range in.Definitions {
+				if v3 > 0 {
 					out.RawByte(',')
 				}
-				(v3).MarshalEasyJSON(out)
+				(v4).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
 	{
-		const prefix string = ",\"Lit\":"
-		out.RawString(prefix)
-		out.String(string(in.Lit))
-	}
-	{
-		const prefix string = ",\"Pos\":"
-		out.RawString(prefix)
-		(in.Pos).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"End\":"
+		const prefix string = ",\"References\":"
 		out.RawString(prefix)
-		(in.End).MarshalEasyJSON(out)
+		if in.References == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.References {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Word) MarshalJSON() ([]byte, error) {
+func (v WorkspaceSymbol) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Word) MarshalEasyJSON(w *jwriter.Writer) {
+func (v WorkspaceSymbol) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Word) UnmarshalJSON(data []byte) error {
+func (v *WorkspaceSymbol) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Word) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *WorkspaceSymbol) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor(l, v)
 }
-func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor1(in *jlexer.Lexer, out *Stmt) {
+func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor1(in *jlexer.Lexer, out *Word) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -159,103 +192,38 @@ func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor1(in *jlexer.Lexer, out
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "Comments":
+		case "Parts":
 			if in.IsNull() {
 				in.Skip()
-				out.Comments = nil
+				out.Parts = nil
 			} else {
 				in.Delim('[')
-				if out.Comments == nil {
+				if out.Parts == nil {
 					if !in.IsDelim(']') {
-						out.Comments = make([]Comment, 0, 0)
+						out.Parts = make([]Node, 0, 1)
 					} else {
-						out.Comments = []Comment{}
+						out.Parts = []Node{}
 					}
 				} else {
-					out.Comments = (out.Comments)[:0]
+					out.Parts = (out.Parts)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v4 Comment
+					var v7 Node
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v4).UnmarshalEasyJSON(in)
+						(v7).UnmarshalEasyJSON(in)
 					}
-					out.Comments = append(out.Comments, v4)
+					out.Parts = append(out.Parts, v7)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "Cmd":
-			if in.IsNull() {
-				in.Skip()
-				out.Cmd = nil
-			} else {
-				if out.Cmd == nil {
-					out.Cmd = new(Node)
-				}
-				if in.IsNull() {
-					in.Skip()
-				} else {
-					(*out.Cmd).UnmarshalEasyJSON(in)
-				}
-			}
-		case "Position":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				(out.Position).UnmarshalEasyJSON(in)
-			}
-		case "Semicolon":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				(out.Semicolon).UnmarshalEasyJSON(in)
-			}
-		case "Negated":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Negated = bool(in.Bool())
-			}
-		case "Background":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Background = bool(in.Bool())
-			}
-		case "Coprocess":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Coprocess = bool(in.Bool())
-			}
-		case "Redirs":
+		case "Lit":
 			if in.IsNull() {
 				in.Skip()
-				out.Redirs = nil
 			} else {
-				in.Delim('[')
-				if out.Redirs == nil {
-					if !in.IsDelim(']') {
-						out.Redirs = make([]Redirect, 0, 0)
-					} else {
-						out.Redirs = []Redirect{}
-					}
-				} else {
-					out.Redirs = (out.Redirs)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v5 Redirect
-					if in.IsNull() {
-						in.Skip()
-					} else {
-						(v5).UnmarshalEasyJSON(in)
-					}
-					out.Redirs = append(out.Redirs, v5)
-					in.WantComma()
-				}
-				in.Delim(']')
+				out.Lit = string(in.String())
 			}
 		case "Pos":
 			if in.IsNull() {
@@ -279,75 +247,30 @@ func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor1(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor1(out *jwriter.Writer, in Stmt) {
+func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor1(out *jwriter.Writer, in Word) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Comments\":"
+		const prefix string = ",\"Parts\":"
 		out.RawString(prefix[1:])
-		if in.Comments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Parts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v6, v7 := range in.Comments {
-				if v6 > 0 {
+			for v8, v9 := range in.Parts {
+				if v8 > 0 {
 					out.RawByte(',')
 				}
-				(v7).MarshalEasyJSON(out)
+				(v9).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
 	{
-		const prefix string = ",\"Cmd\":"
-		out.RawString(prefix)
-		if in.Cmd == nil {
-			out.RawString("null")
-		} else {
-			(*in.Cmd).MarshalEasyJSON(out)
-		}
-	}
-	{
-		const prefix string = ",\"Position\":"
-		out.RawString(prefix)
-		(in.Position).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"Semicolon\":"
-		out.RawString(prefix)
-		(in.Semicolon).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"Negated\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Negated))
-	}
-	{
-		const prefix string = ",\"Background\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Background))
-	}
-	{
-		const prefix string = ",\"Coprocess\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Coprocess))
-	}
-	{
-		const prefix string = ",\"Redirs\":"
+		const prefix string = ",\"Lit\":"
 		out.RawString(prefix)
-		if in.Redirs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v8, v9 := range in.Redirs {
-				if v8 > 0 {
-					out.RawByte(',')
-				}
-				(v9).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Lit))
 	}
 	{
 		const prefix string = ",\"Pos\":"
@@ -363,29 +286,29 @@ func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor1(out *jwriter.Writer,
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Stmt) MarshalJSON() ([]byte, error) {
+func (v Word) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor1(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Stmt) MarshalEasyJSON(w *jwriter.Writer) {
+func (v Word) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor1(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Stmt) UnmarshalJSON(data []byte) error {
+func (v *Word) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor1(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Stmt) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *Word) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor1(l, v)
 }
-func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor2(in *jlexer.Lexer, out *Result) {
+func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor2(in *jlexer.Lexer, out *Warning) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -394,43 +317,34 @@ func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor2(in *jlexer.Lexer, out
 		in.Skip()
 		return
 	}
-	out.ParseError = new(ParseError)
 	in.Delim('{')
 	for !in.IsDelim('}') {
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "file":
+		case "Message":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.File).UnmarshalEasyJSON(in)
+				out.Message = string(in.String())
 			}
-		case "text":
+		case "Text":
 			if in.IsNull() {
 				in.Skip()
 			} else {
 				out.Text = string(in.String())
 			}
-		case "parseError":
+		case "Pos":
 			if in.IsNull() {
 				in.Skip()
-				out.ParseError = nil
 			} else {
-				if out.ParseError == nil {
-					out.ParseError = new(ParseError)
-				}
-				if in.IsNull() {
-					in.Skip()
-				} else {
-					(*out.ParseError).UnmarshalEasyJSON(in)
-				}
+				(out.Pos).UnmarshalEasyJSON(in)
 			}
-		case "message":
+		case "End":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Message = string(in.String())
+				(out.End).UnmarshalEasyJSON(in)
 			}
 		default:
 			in.SkipRecursive()
@@ -442,61 +356,57 @@ func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor2(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor2(out *jwriter.Writer, in Result) {
+func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor2(out *jwriter.Writer, in Warning) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"file\":"
+		const prefix string = ",\"Message\":"
 		out.RawString(prefix[1:])
-		(in.File).MarshalEasyJSON(out)
+		out.String(string(in.Message))
 	}
 	{
-		const prefix string = ",\"text\":"
+		const prefix string = ",\"Text\":"
 		out.RawString(prefix)
 		out.String(string(in.Text))
 	}
 	{
-		const prefix string = ",\"parseError\":"
+		const prefix string = ",\"Pos\":"
 		out.RawString(prefix)
-		if in.ParseError == nil {
-			out.RawString("null")
-		} else {
-			(*in.ParseError).MarshalEasyJSON(out)
-		}
+		(in.Pos).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"message\":"
+		const prefix string = ",\"End\":"
 		out.RawString(prefix)
-		out.String(string(in.Message))
+		(in.End).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Result) MarshalJSON() ([]byte, error) {
+func (v Warning) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Result) MarshalEasyJSON(w *jwriter.Writer) {
+func (v Warning) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Result) UnmarshalJSON(data []byte) error {
+func (v *Warning) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Result) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *Warning) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor2(l, v)
 }
-func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor3(in *jlexer.Lexer, out *Redirect) {
+func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor3(in *jlexer.Lexer, out *Symbol) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -510,71 +420,71 @@ func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor3(in *jlexer.Lexer, out
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "OpPos":
+		case "Name":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.OpPos).UnmarshalEasyJSON(in)
+				out.Name = string(in.String())
 			}
-		case "Op":
+		case "Kind":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Op = string(in.String())
+				out.Kind = string(in.String())
 			}
-		case "N":
+		case "Definitions":
 			if in.IsNull() {
 				in.Skip()
-				out.N = nil
+				out.Definitions = nil
 			} else {
-				if out.N == nil {
-					out.N = new(Lit)
-				}
-				if in.IsNull() {
-					in.Skip()
+				in.Delim('[')
+				if out.Definitions == nil {
+					if !in.IsDelim(']') {
+						out.Definitions = make([]Occurrence, 0, 1)
+					} else {
+						out.Definitions = []Occurrence{}
+					}
 				} else {
-					(*out.N).UnmarshalEasyJSON(in)
-				}
-			}
-		case "Word":
-			if in.IsNull() {
-				in.Skip()
-				out.Word = nil
-			} else {
-				if out.Word == nil {
-					out.Word = new(Word)
+					out.Definitions = (out.Definitions)[:0]
 				}
-				if in.IsNull() {
-					in.Skip()
-				} else {
-					(*out.Word).UnmarshalEasyJSON(in)
+				for !in.IsDelim(']') {
+					var v10 Occurrence
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v10).UnmarshalEasyJSON(in)
+					}
+					out.Definitions = append(out.Definitions, v10)
+					in.WantComma()
 				}
+				in.Delim(']')
 			}
-		case "Hdoc":
+		case "References":
 			if in.IsNull() {
 				in.Skip()
-				out.Hdoc = nil
+				out.References = nil
 			} else {
-				if out.Hdoc == nil {
-					out.Hdoc = new(Word)
-				}
-				if in.IsNull() {
-					in.Skip()
+				in.Delim('[')
+				if out.References == nil {
+					if !in.IsDelim(']') {
+						out.References = make([]Occurrence, 0, 1)
+					} else {
+						out.References = []Occurrence{}
+					}
 				} else {
-					(*out.Hdoc).UnmarshalEasyJSON(in)
+					out.References = (out.References)[:0]
 				}
-			}
-		case "Pos":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				(out.Pos).UnmarshalEasyJSON(in)
-			}
-		case "End":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				(out.End).UnmarshalEasyJSON(in)
+				for !in.IsDelim(']') {
+					var v11 Occurrence
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v11).UnmarshalEasyJSON(in)
+					}
+					out.References = append(out.References, v11)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -586,84 +496,79 @@ func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor3(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor3(out *jwriter.Writer, in Redirect) {
+func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor3(out *jwriter.Writer, in Symbol) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"OpPos\":"
+		const prefix string = ",\"Name\":"
 		out.RawString(prefix[1:])
-		(in.OpPos).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"Op\":"
-		out.RawString(prefix)
-		out.String(string(in.Op))
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"N\":"
+		const prefix string = ",\"Kind\":"
 		out.RawString(prefix)
-		if in.N == nil {
-			out.RawString("null")
-		} else {
-			(*in.N).MarshalEasyJSON(out)
-		}
+		out.String(string(in.Kind))
 	}
 	{
-		const prefix string = ",\"Word\":"
+		const prefix string = ",\"Definitions\":"
 		out.RawString(prefix)
-		if in.Word == nil {
+		if in.Definitions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
-			(*in.Word).MarshalEasyJSON(out)
+			out.RawByte('[')
+			for v12, v13 := range in.Definitions {
+				if v12 > 0 {
+					out.RawByte(',')
+				}
+				(v13).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
 		}
 	}
 	{
-		const prefix string = ",\"Hdoc\":"
+		const prefix string = ",\"References\":"
 		out.RawString(prefix)
-		if in.Hdoc == nil {
+		if in.References == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
-			(*in.Hdoc).MarshalEasyJSON(out)
+			out.RawByte('[')
+			for v14, v15 := range in.References {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				(v15).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
 		}
 	}
-	{
-		const prefix string = ",\"Pos\":"
-		out.RawString(prefix)
-		(in.Pos).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"End\":"
-		out.RawString(prefix)
-		(in.End).MarshalEasyJSON(out)
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Redirect) MarshalJSON() ([]byte, error) {
+func (v Symbol) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Redirect) MarshalEasyJSON(w *jwriter.Writer) {
+func (v Symbol) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Redirect) UnmarshalJSON(data []byte) error {
+func (v *Symbol) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Redirect) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *Symbol) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor3(l, v)
 }
-func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor4(in *jlexer.Lexer, out *Pos) {
+func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor4(in *jlexer.Lexer, out *Stmt) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -677,23 +582,115 @@ func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor4(in *jlexer.Lexer, out
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "Offset":
+		case "Comments":
 			if in.IsNull() {
 				in.Skip()
+				out.Comments = nil
 			} else {
-				out.Offset = uint(in.Uint())
+				in.Delim('[')
+				if out.Comments == nil {
+					if !in.IsDelim(']') {
+						out.Comments = make([]Comment, 0, 0)
+					} else {
+						out.Comments = []Comment{}
+					}
+				} else {
+					out.Comments = (out.Comments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v16 Comment
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v16).UnmarshalEasyJSON(in)
+					}
+					out.Comments = append(out.Comments, v16)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-		case "Line":
+		case "Cmd":
 			if in.IsNull() {
 				in.Skip()
+				out.Cmd = nil
 			} else {
-				out.Line = uint(in.Uint())
+				if out.Cmd == nil {
+					out.Cmd = new(Node)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Cmd).UnmarshalEasyJSON(in)
+				}
 			}
-		case "Col":
+		case "Position":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Col = uint(in.Uint())
+				(out.Position).UnmarshalEasyJSON(in)
+			}
+		case "Semicolon":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Semicolon).UnmarshalEasyJSON(in)
+			}
+		case "Negated":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Negated = bool(in.Bool())
+			}
+		case "Background":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Background = bool(in.Bool())
+			}
+		case "Coprocess":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Coprocess = bool(in.Bool())
+			}
+		case "Redirs":
+			if in.IsNull() {
+				in.Skip()
+				out.Redirs = nil
+			} else {
+				in.Delim('[')
+				if out.Redirs == nil {
+					if !in.IsDelim(']') {
+						out.Redirs = make([]Redirect, 0, 0)
+					} else {
+						out.Redirs = []Redirect{}
+					}
+				} else {
+					out.Redirs = (out.Redirs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v17 Redirect
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v17).UnmarshalEasyJSON(in)
+					}
+					out.Redirs = append(out.Redirs, v17)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
 			}
 		default:
 			in.SkipRecursive()
@@ -705,52 +702,3517 @@ func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor4(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor4(out *jwriter.Writer, in Pos) {
+func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor4(out *jwriter.Writer, in Stmt) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Offset\":"
+		const prefix string = ",\"Comments\":"
 		out.RawString(prefix[1:])
-		out.Uint(uint(in.Offset))
+		if in.Comments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v18, v19 := range in.Comments {
+				if v18 > 0 {
+					out.RawByte(',')
+				}
+				(v19).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"Line\":"
+		const prefix string = ",\"Cmd\":"
 		out.RawString(prefix)
-		out.Uint(uint(in.Line))
+		if in.Cmd == nil {
+			out.RawString("null")
+		} else {
+			(*in.Cmd).MarshalEasyJSON(out)
+		}
 	}
 	{
-		const prefix string = ",\"Col\":"
+		const prefix string = ",\"Position\":"
 		out.RawString(prefix)
-		out.Uint(uint(in.Col))
+		(in.Position).MarshalEasyJSON(out)
 	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v Pos) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor4(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
+	{
+		const prefix string = ",\"Semicolon\":"
+		out.RawString(prefix)
+		(in.Semicolon).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Negated\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Negated))
+	}
+	{
+		const prefix string = ",\"Background\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Background))
+	}
+	{
+		const prefix string = ",\"Coprocess\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Coprocess))
+	}
+	{
+		const prefix string = ",\"Redirs\":"
+		out.RawString(prefix)
+		if in.Redirs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v20, v21 := range in.Redirs {
+				if v20 > 0 {
+					out.RawByte(',')
+				}
+				(v21).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Stmt) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Pos) MarshalEasyJSON(w *jwriter.Writer) {
+func (v Stmt) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Pos) UnmarshalJSON(data []byte) error {
+func (v *Stmt) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Stmt) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor4(l, v)
+}
+func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor5(in *jlexer.Lexer, out *Simplification) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Node":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Node = string(in.String())
+			}
+		case "Text":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Text = string(in.String())
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor5(out *jwriter.Writer, in Simplification) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Node\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Node))
+	}
+	{
+		const prefix string = ",\"Text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Simplification) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Simplification) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Simplification) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Simplification) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor5(l, v)
+}
+func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor6(in *jlexer.Lexer, out *Scope) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Kind":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Kind = string(in.String())
+			}
+		case "Name":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Name = string(in.String())
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		case "Symbols":
+			if in.IsNull() {
+				in.Skip()
+				out.Symbols = nil
+			} else {
+				in.Delim('[')
+				if out.Symbols == nil {
+					if !in.IsDelim(']') {
+						out.Symbols = make([]Symbol, 0, 0)
+					} else {
+						out.Symbols = []Symbol{}
+					}
+				} else {
+					out.Symbols = (out.Symbols)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v22 Symbol
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v22).UnmarshalEasyJSON(in)
+					}
+					out.Symbols = append(out.Symbols, v22)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Children":
+			if in.IsNull() {
+				in.Skip()
+				out.Children = nil
+			} else {
+				in.Delim('[')
+				if out.Children == nil {
+					if !in.IsDelim(']') {
+						out.Children = make([]Scope, 0, 0)
+					} else {
+						out.Children = []Scope{}
+					}
+				} else {
+					out.Children = (out.Children)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v23 Scope
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v23).UnmarshalEasyJSON(in)
+					}
+					out.Children = append(out.Children, v23)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor6(out *jwriter.Writer, in Scope) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Kind\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Kind))
+	}
+	{
+		const prefix string = ",\"Name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Symbols\":"
+		out.RawString(prefix)
+		if in.Symbols == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v24, v25 := range in.Symbols {
+				if v24 > 0 {
+					out.RawByte(',')
+				}
+				(v25).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Children\":"
+		out.RawString(prefix)
+		if in.Children == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v26, v27 := range in.Children {
+				if v26 > 0 {
+					out.RawByte(',')
+				}
+				(v27).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Scope) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Scope) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Scope) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Scope) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor6(l, v)
+}
+func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor7(in *jlexer.Lexer, out *Result) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	out.ParseError = new(ParseError)
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "file":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.File).UnmarshalEasyJSON(in)
+			}
+		case "text":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Text = string(in.String())
+			}
+		case "parseError":
+			if in.IsNull() {
+				in.Skip()
+				out.ParseError = nil
+			} else {
+				if out.ParseError == nil {
+					out.ParseError = new(ParseError)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.ParseError).UnmarshalEasyJSON(in)
+				}
+			}
+		case "message":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Message = string(in.String())
+			}
+		case "diagnostics":
+			if in.IsNull() {
+				in.Skip()
+				out.Diagnostics = nil
+			} else {
+				in.Delim('[')
+				if out.Diagnostics == nil {
+					if !in.IsDelim(']') {
+						out.Diagnostics = make([]Diagnostic, 0, 0)
+					} else {
+						out.Diagnostics = []Diagnostic{}
+					}
+				} else {
+					out.Diagnostics = (out.Diagnostics)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v28 Diagnostic
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v28).UnmarshalEasyJSON(in)
+					}
+					out.Diagnostics = append(out.Diagnostics, v28)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "symbols":
+			if in.IsNull() {
+				in.Skip()
+				out.Symbols = nil
+			} else {
+				if out.Symbols == nil {
+					out.Symbols = new(Scope)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Symbols).UnmarshalEasyJSON(in)
+				}
+			}
+		case "symbol":
+			if in.IsNull() {
+				in.Skip()
+				out.Symbol = nil
+			} else {
+				if out.Symbol == nil {
+					out.Symbol = new(Symbol)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Symbol).UnmarshalEasyJSON(in)
+				}
+			}
+		case "commands":
+			if in.IsNull() {
+				in.Skip()
+				out.Commands = nil
+			} else {
+				if out.Commands == nil {
+					out.Commands = new(CommandInventory)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Commands).UnmarshalEasyJSON(in)
+				}
+			}
+		case "environment":
+			if in.IsNull() {
+				in.Skip()
+				out.Environment = nil
+			} else {
+				in.Delim('[')
+				if out.Environment == nil {
+					if !in.IsDelim(']') {
+						out.Environment = make([]EnvironmentVariable, 0, 1)
+					} else {
+						out.Environment = []EnvironmentVariable{}
+					}
+				} else {
+					out.Environment = (out.Environment)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v29 EnvironmentVariable
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v29).UnmarshalEasyJSON(in)
+					}
+					out.Environment = append(out.Environment, v29)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "includes":
+			if in.IsNull() {
+				in.Skip()
+				out.Includes = nil
+			} else {
+				if out.Includes == nil {
+					out.Includes = new(IncludeGraph)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Includes).UnmarshalEasyJSON(in)
+				}
+			}
+		case "workspaceSymbol":
+			if in.IsNull() {
+				in.Skip()
+				out.Workspace = nil
+			} else {
+				if out.Workspace == nil {
+					out.Workspace = new(WorkspaceSymbol)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Workspace).UnmarshalEasyJSON(in)
+				}
+			}
+		case "unusedFunctions":
+			if in.IsNull() {
+				in.Skip()
+				out.Unused = nil
+			} else {
+				in.Delim('[')
+				if out.Unused == nil {
+					if !in.IsDelim(']') {
+						out.Unused = make([]WorkspaceSymbol, 0, 0)
+					} else {
+						out.Unused = []WorkspaceSymbol{}
+					}
+				} else {
+					out.Unused = (out.Unused)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v30 WorkspaceSymbol
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v30).UnmarshalEasyJSON(in)
+					}
+					out.Unused = append(out.Unused, v30)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "simplifications":
+			if in.IsNull() {
+				in.Skip()
+				out.Simplifications = nil
+			} else {
+				in.Delim('[')
+				if out.Simplifications == nil {
+					if !in.IsDelim(']') {
+						out.Simplifications = make([]Simplification, 0, 0)
+					} else {
+						out.Simplifications = []Simplification{}
+					}
+				} else {
+					out.Simplifications = (out.Simplifications)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v31 Simplification
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v31).UnmarshalEasyJSON(in)
+					}
+					out.Simplifications = append(out.Simplifications, v31)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "fixes":
+			if in.IsNull() {
+				in.Skip()
+				out.Fixes = nil
+			} else {
+				in.Delim('[')
+				if out.Fixes == nil {
+					if !in.IsDelim(']') {
+						out.Fixes = make([]Fix, 0, 0)
+					} else {
+						out.Fixes = []Fix{}
+					}
+				} else {
+					out.Fixes = (out.Fixes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v32 Fix
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v32).UnmarshalEasyJSON(in)
+					}
+					out.Fixes = append(out.Fixes, v32)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "sourceMap":
+			if in.IsNull() {
+				in.Skip()
+				out.SourceMap = nil
+			} else {
+				in.Delim('[')
+				if out.SourceMap == nil {
+					if !in.IsDelim(']') {
+						out.SourceMap = make([]Mapping, 0, 0)
+					} else {
+						out.SourceMap = []Mapping{}
+					}
+				} else {
+					out.SourceMap = (out.SourceMap)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v33 Mapping
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v33).UnmarshalEasyJSON(in)
+					}
+					out.SourceMap = append(out.SourceMap, v33)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "passes":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Passes = int(in.Int())
+			}
+		case "diff":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Diff = string(in.String())
+			}
+		case "warnings":
+			if in.IsNull() {
+				in.Skip()
+				out.Warnings = nil
+			} else {
+				in.Delim('[')
+				if out.Warnings == nil {
+					if !in.IsDelim(']') {
+						out.Warnings = make([]Warning, 0, 0)
+					} else {
+						out.Warnings = []Warning{}
+					}
+				} else {
+					out.Warnings = (out.Warnings)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v34 Warning
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v34).UnmarshalEasyJSON(in)
+					}
+					out.Warnings = append(out.Warnings, v34)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "parseErrors":
+			if in.IsNull() {
+				in.Skip()
+				out.ParseErrors = nil
+			} else {
+				in.Delim('[')
+				if out.ParseErrors == nil {
+					if !in.IsDelim(']') {
+						out.ParseErrors = make([]ParseError, 0, 0)
+					} else {
+						out.ParseErrors = []ParseError{}
+					}
+				} else {
+					out.ParseErrors = (out.ParseErrors)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v35 ParseError
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v35).UnmarshalEasyJSON(in)
+					}
+					out.ParseErrors = append(out.ParseErrors, v35)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "lineEnding":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LineEnding = string(in.String())
+			}
+		case "byteOrderMark":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ByteOrderMark = bool(in.Bool())
+			}
+		case "preset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Preset = string(in.String())
+			}
+		case "modeline":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Modeline = string(in.String())
+			}
+		case "parserOptions":
+			if in.IsNull() {
+				in.Skip()
+				out.ParserOptions = nil
+			} else {
+				if out.ParserOptions == nil {
+					out.ParserOptions = new(ParserOptions)
+				}
+				easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor8(in, out.ParserOptions)
+			}
+		case "printerOptions":
+			if in.IsNull() {
+				in.Skip()
+				out.PrinterOptions = nil
+			} else {
+				if out.PrinterOptions == nil {
+					out.PrinterOptions = new(PrinterOptions)
+				}
+				easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor9(in, out.PrinterOptions)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor7(out *jwriter.Writer, in Result) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"file\":"
+		out.RawString(prefix[1:])
+		(in.File).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"parseError\":"
+		out.RawString(prefix)
+		if in.ParseError == nil {
+			out.RawString("null")
+		} else {
+			(*in.ParseError).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	{
+		const prefix string = ",\"diagnostics\":"
+		out.RawString(prefix)
+		if in.Diagnostics == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v36, v37 := range in.Diagnostics {
+				if v36 > 0 {
+					out.RawByte(',')
+				}
+				(v37).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"symbols\":"
+		out.RawString(prefix)
+		if in.Symbols == nil {
+			out.RawString("null")
+		} else {
+			(*in.Symbols).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"symbol\":"
+		out.RawString(prefix)
+		if in.Symbol == nil {
+			out.RawString("null")
+		} else {
+			(*in.Symbol).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"commands\":"
+		out.RawString(prefix)
+		if in.Commands == nil {
+			out.RawString("null")
+		} else {
+			(*in.Commands).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"environment\":"
+		out.RawString(prefix)
+		if in.Environment == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v38, v39 := range in.Environment {
+				if v38 > 0 {
+					out.RawByte(',')
+				}
+				(v39).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"includes\":"
+		out.RawString(prefix)
+		if in.Includes == nil {
+			out.RawString("null")
+		} else {
+			(*in.Includes).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"workspaceSymbol\":"
+		out.RawString(prefix)
+		if in.Workspace == nil {
+			out.RawString("null")
+		} else {
+			(*in.Workspace).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"unusedFunctions\":"
+		out.RawString(prefix)
+		if in.Unused == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v40, v41 := range in.Unused {
+				if v40 > 0 {
+					out.RawByte(',')
+				}
+				(v41).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"simplifications\":"
+		out.RawString(prefix)
+		if in.Simplifications == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v42, v43 := range in.Simplifications {
+				if v42 > 0 {
+					out.RawByte(',')
+				}
+				(v43).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"fixes\":"
+		out.RawString(prefix)
+		if in.Fixes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v44, v45 := range in.Fixes {
+				if v44 > 0 {
+					out.RawByte(',')
+				}
+				(v45).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"sourceMap\":"
+		out.RawString(prefix)
+		if in.SourceMap == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v46, v47 := range in.SourceMap {
+				if v46 > 0 {
+					out.RawByte(',')
+				}
+				(v47).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"passes\":"
+		out.RawString(prefix)
+		out.Int(int(in.Passes))
+	}
+	{
+		const prefix string = ",\"diff\":"
+		out.RawString(prefix)
+		out.String(string(in.Diff))
+	}
+	{
+		const prefix string = ",\"warnings\":"
+		out.RawString(prefix)
+		if in.Warnings == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v48, v49 := range in.Warnings {
+				if v48 > 0 {
+					out.RawByte(',')
+				}
+				(v49).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"parseErrors\":"
+		out.RawString(prefix)
+		if in.ParseErrors == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v50, v51 := range in.ParseErrors {
+				if v50 > 0 {
+					out.RawByte(',')
+				}
+				(v51).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"lineEnding\":"
+		out.RawString(prefix)
+		out.String(string(in.LineEnding))
+	}
+	{
+		const prefix string = ",\"byteOrderMark\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ByteOrderMark))
+	}
+	{
+		const prefix string = ",\"preset\":"
+		out.RawString(prefix)
+		out.String(string(in.Preset))
+	}
+	{
+		const prefix string = ",\"modeline\":"
+		out.RawString(prefix)
+		out.String(string(in.Modeline))
+	}
+	{
+		const prefix string = ",\"parserOptions\":"
+		out.RawString(prefix)
+		if in.ParserOptions == nil {
+			out.RawString("null")
+		} else {
+			easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor8(out, *in.ParserOptions)
+		}
+	}
+	{
+		const prefix string = ",\"printerOptions\":"
+		out.RawString(prefix)
+		if in.PrinterOptions == nil {
+			out.RawString("null")
+		} else {
+			easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor9(out, *in.PrinterOptions)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Result) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Result) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Result) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Result) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor7(l, v)
+}
+func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor9(in *jlexer.Lexer, out *PrinterOptions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Indent":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Indent = uint(in.Uint())
+			}
+		case "BinaryNextLine":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.BinaryNextLine = bool(in.Bool())
+			}
+		case "SwitchCaseIndent":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.SwitchCaseIndent = bool(in.Bool())
+			}
+		case "SpaceRedirects":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.SpaceRedirects = bool(in.Bool())
+			}
+		case "KeepPadding":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.KeepPadding = bool(in.Bool())
+			}
+		case "Minify":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Minify = bool(in.Bool())
+			}
+		case "SingleLine":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.SingleLine = bool(in.Bool())
+			}
+		case "FunctionNextLine":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.FunctionNextLine = bool(in.Bool())
+			}
+		case "PrintWidth":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PrintWidth = uint(in.Uint())
+			}
+		case "SingleQuote":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.SingleQuote = bool(in.Bool())
+			}
+		case "BraceParams":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.BraceParams = bool(in.Bool())
+			}
+		case "PlainAnsiCQuotes":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.PlainAnsiCQuotes = bool(in.Bool())
+			}
+		case "LineEnding":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LineEnding = LineEnding(in.Int())
+			}
+		case "MaxBlankLines":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.MaxBlankLines = uint(in.Uint())
+			}
+		case "AlignComments":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AlignComments = bool(in.Bool())
+			}
+		case "AlignAssignments":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.AlignAssignments = bool(in.Bool())
+			}
+		case "FunctionStyle":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.FunctionStyle = FunctionStyle(in.Int())
+			}
+		case "DoubleBrackets":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.DoubleBrackets = bool(in.Bool())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor9(out *jwriter.Writer, in PrinterOptions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Indent\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.Indent))
+	}
+	{
+		const prefix string = ",\"BinaryNextLine\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.BinaryNextLine))
+	}
+	{
+		const prefix string = ",\"SwitchCaseIndent\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.SwitchCaseIndent))
+	}
+	{
+		const prefix string = ",\"SpaceRedirects\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.SpaceRedirects))
+	}
+	{
+		const prefix string = ",\"KeepPadding\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.KeepPadding))
+	}
+	{
+		const prefix string = ",\"Minify\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Minify))
+	}
+	{
+		const prefix string = ",\"SingleLine\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.SingleLine))
+	}
+	{
+		const prefix string = ",\"FunctionNextLine\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.FunctionNextLine))
+	}
+	{
+		const prefix string = ",\"PrintWidth\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.PrintWidth))
+	}
+	{
+		const prefix string = ",\"SingleQuote\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.SingleQuote))
+	}
+	{
+		const prefix string = ",\"BraceParams\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.BraceParams))
+	}
+	{
+		const prefix string = ",\"PlainAnsiCQuotes\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.PlainAnsiCQuotes))
+	}
+	{
+		const prefix string = ",\"LineEnding\":"
+		out.RawString(prefix)
+		out.Int(int(in.LineEnding))
+	}
+	{
+		const prefix string = ",\"MaxBlankLines\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.MaxBlankLines))
+	}
+	{
+		const prefix string = ",\"AlignComments\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.AlignComments))
+	}
+	{
+		const prefix string = ",\"AlignAssignments\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.AlignAssignments))
+	}
+	{
+		const prefix string = ",\"FunctionStyle\":"
+		out.RawString(prefix)
+		out.Int(int(in.FunctionStyle))
+	}
+	{
+		const prefix string = ",\"DoubleBrackets\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.DoubleBrackets))
+	}
+	out.RawByte('}')
+}
+func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor8(in *jlexer.Lexer, out *ParserOptions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "KeepComments":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.KeepComments = bool(in.Bool())
+			}
+		case "Variant":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Variant = syntax.LangVariant(in.Int())
+			}
+		case "StopAt":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.StopAt = string(in.String())
+			}
+		case "RecoverErrors":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.RecoverErrors = int(in.Int())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor8(out *jwriter.Writer, in ParserOptions) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"KeepComments\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.KeepComments))
+	}
+	{
+		const prefix string = ",\"Variant\":"
+		out.RawString(prefix)
+		out.Int(int(in.Variant))
+	}
+	{
+		const prefix string = ",\"StopAt\":"
+		out.RawString(prefix)
+		out.String(string(in.StopAt))
+	}
+	{
+		const prefix string = ",\"RecoverErrors\":"
+		out.RawString(prefix)
+		out.Int(int(in.RecoverErrors))
+	}
+	out.RawByte('}')
+}
+func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor10(in *jlexer.Lexer, out *Report) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "simplifications":
+			if in.IsNull() {
+				in.Skip()
+				out.Simplifications = nil
+			} else {
+				in.Delim('[')
+				if out.Simplifications == nil {
+					if !in.IsDelim(']') {
+						out.Simplifications = make([]Simplification, 0, 0)
+					} else {
+						out.Simplifications = []Simplification{}
+					}
+				} else {
+					out.Simplifications = (out.Simplifications)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v52 Simplification
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v52).UnmarshalEasyJSON(in)
+					}
+					out.Simplifications = append(out.Simplifications, v52)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "fixes":
+			if in.IsNull() {
+				in.Skip()
+				out.Fixes = nil
+			} else {
+				in.Delim('[')
+				if out.Fixes == nil {
+					if !in.IsDelim(']') {
+						out.Fixes = make([]Fix, 0, 0)
+					} else {
+						out.Fixes = []Fix{}
+					}
+				} else {
+					out.Fixes = (out.Fixes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v53 Fix
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v53).UnmarshalEasyJSON(in)
+					}
+					out.Fixes = append(out.Fixes, v53)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "sourceMap":
+			if in.IsNull() {
+				in.Skip()
+				out.SourceMap = nil
+			} else {
+				in.Delim('[')
+				if out.SourceMap == nil {
+					if !in.IsDelim(']') {
+						out.SourceMap = make([]Mapping, 0, 0)
+					} else {
+						out.SourceMap = []Mapping{}
+					}
+				} else {
+					out.SourceMap = (out.SourceMap)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v54 Mapping
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v54).UnmarshalEasyJSON(in)
+					}
+					out.SourceMap = append(out.SourceMap, v54)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "passes":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Passes = int(in.Int())
+			}
+		case "diff":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Diff = string(in.String())
+			}
+		case "warnings":
+			if in.IsNull() {
+				in.Skip()
+				out.Warnings = nil
+			} else {
+				in.Delim('[')
+				if out.Warnings == nil {
+					if !in.IsDelim(']') {
+						out.Warnings = make([]Warning, 0, 0)
+					} else {
+						out.Warnings = []Warning{}
+					}
+				} else {
+					out.Warnings = (out.Warnings)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v55 Warning
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v55).UnmarshalEasyJSON(in)
+					}
+					out.Warnings = append(out.Warnings, v55)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "parseErrors":
+			if in.IsNull() {
+				in.Skip()
+				out.ParseErrors = nil
+			} else {
+				in.Delim('[')
+				if out.ParseErrors == nil {
+					if !in.IsDelim(']') {
+						out.ParseErrors = make([]ParseError, 0, 0)
+					} else {
+						out.ParseErrors = []ParseError{}
+					}
+				} else {
+					out.ParseErrors = (out.ParseErrors)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v56 ParseError
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v56).UnmarshalEasyJSON(in)
+					}
+					out.ParseErrors = append(out.ParseErrors, v56)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "lineEnding":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.LineEnding = string(in.String())
+			}
+		case "byteOrderMark":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.ByteOrderMark = bool(in.Bool())
+			}
+		case "preset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Preset = string(in.String())
+			}
+		case "modeline":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Modeline = string(in.String())
+			}
+		case "parserOptions":
+			if in.IsNull() {
+				in.Skip()
+				out.ParserOptions = nil
+			} else {
+				if out.ParserOptions == nil {
+					out.ParserOptions = new(ParserOptions)
+				}
+				easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor8(in, out.ParserOptions)
+			}
+		case "printerOptions":
+			if in.IsNull() {
+				in.Skip()
+				out.PrinterOptions = nil
+			} else {
+				if out.PrinterOptions == nil {
+					out.PrinterOptions = new(PrinterOptions)
+				}
+				easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor9(in, out.PrinterOptions)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor10(out *jwriter.Writer, in Report) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"simplifications\":"
+		out.RawString(prefix[1:])
+		if in.Simplifications == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v57, v58 := range in.Simplifications {
+				if v57 > 0 {
+					out.RawByte(',')
+				}
+				(v58).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"fixes\":"
+		out.RawString(prefix)
+		if in.Fixes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v59, v60 := range in.Fixes {
+				if v59 > 0 {
+					out.RawByte(',')
+				}
+				(v60).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"sourceMap\":"
+		out.RawString(prefix)
+		if in.SourceMap == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v61, v62 := range in.SourceMap {
+				if v61 > 0 {
+					out.RawByte(',')
+				}
+				(v62).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"passes\":"
+		out.RawString(prefix)
+		out.Int(int(in.Passes))
+	}
+	{
+		const prefix string = ",\"diff\":"
+		out.RawString(prefix)
+		out.String(string(in.Diff))
+	}
+	{
+		const prefix string = ",\"warnings\":"
+		out.RawString(prefix)
+		if in.Warnings == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v63, v64 := range in.Warnings {
+				if v63 > 0 {
+					out.RawByte(',')
+				}
+				(v64).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"parseErrors\":"
+		out.RawString(prefix)
+		if in.ParseErrors == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v65, v66 := range in.ParseErrors {
+				if v65 > 0 {
+					out.RawByte(',')
+				}
+				(v66).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"lineEnding\":"
+		out.RawString(prefix)
+		out.String(string(in.LineEnding))
+	}
+	{
+		const prefix string = ",\"byteOrderMark\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ByteOrderMark))
+	}
+	{
+		const prefix string = ",\"preset\":"
+		out.RawString(prefix)
+		out.String(string(in.Preset))
+	}
+	{
+		const prefix string = ",\"modeline\":"
+		out.RawString(prefix)
+		out.String(string(in.Modeline))
+	}
+	{
+		const prefix string = ",\"parserOptions\":"
+		out.RawString(prefix)
+		if in.ParserOptions == nil {
+			out.RawString("null")
+		} else {
+			easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor8(out, *in.ParserOptions)
+		}
+	}
+	{
+		const prefix string = ",\"printerOptions\":"
+		out.RawString(prefix)
+		if in.PrinterOptions == nil {
+			out.RawString("null")
+		} else {
+			easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor9(out, *in.PrinterOptions)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Report) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Report) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Report) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Report) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor10(l, v)
+}
+func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor11(in *jlexer.Lexer, out *Redirect) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "OpPos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.OpPos).UnmarshalEasyJSON(in)
+			}
+		case "Op":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Op = string(in.String())
+			}
+		case "N":
+			if in.IsNull() {
+				in.Skip()
+				out.N = nil
+			} else {
+				if out.N == nil {
+					out.N = new(Lit)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.N).UnmarshalEasyJSON(in)
+				}
+			}
+		case "Word":
+			if in.IsNull() {
+				in.Skip()
+				out.Word = nil
+			} else {
+				if out.Word == nil {
+					out.Word = new(Word)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Word).UnmarshalEasyJSON(in)
+				}
+			}
+		case "Hdoc":
+			if in.IsNull() {
+				in.Skip()
+				out.Hdoc = nil
+			} else {
+				if out.Hdoc == nil {
+					out.Hdoc = new(Word)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Hdoc).UnmarshalEasyJSON(in)
+				}
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor11(out *jwriter.Writer, in Redirect) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"OpPos\":"
+		out.RawString(prefix[1:])
+		(in.OpPos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Op\":"
+		out.RawString(prefix)
+		out.String(string(in.Op))
+	}
+	{
+		const prefix string = ",\"N\":"
+		out.RawString(prefix)
+		if in.N == nil {
+			out.RawString("null")
+		} else {
+			(*in.N).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"Word\":"
+		out.RawString(prefix)
+		if in.Word == nil {
+			out.RawString("null")
+		} else {
+			(*in.Word).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"Hdoc\":"
+		out.RawString(prefix)
+		if in.Hdoc == nil {
+			out.RawString("null")
+		} else {
+			(*in.Hdoc).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Redirect) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor11(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Redirect) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor11(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Redirect) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor11(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Redirect) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor11(l, v)
+}
+func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor12(in *jlexer.Lexer, out *Pos) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Offset":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Offset = uint(in.Uint())
+			}
+		case "Line":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Line = uint(in.Uint())
+			}
+		case "Col":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Col = uint(in.Uint())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor12(out *jwriter.Writer, in Pos) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Offset\":"
+		out.RawString(prefix[1:])
+		out.Uint(uint(in.Offset))
+	}
+	{
+		const prefix string = ",\"Line\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.Line))
+	}
+	{
+		const prefix string = ",\"Col\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.Col))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Pos) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor12(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Pos) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor12(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Pos) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor12(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Pos) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor12(l, v)
+}
+func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor13(in *jlexer.Lexer, out *ParseError) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "Filename":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Filename = string(in.String())
+			}
+		case "Text":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Text = string(in.String())
+			}
+		case "Incomplete":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Incomplete = bool(in.Bool())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor13(out *jwriter.Writer, in ParseError) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix[1:])
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Filename\":"
+		out.RawString(prefix)
+		out.String(string(in.Filename))
+	}
+	{
+		const prefix string = ",\"Text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"Incomplete\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Incomplete))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ParseError) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor13(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ParseError) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor13(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ParseError) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor13(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ParseError) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor13(l, v)
+}
+func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor14(in *jlexer.Lexer, out *Occurrence) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Kind":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Kind = string(in.String())
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor14(out *jwriter.Writer, in Occurrence) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Kind\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Kind))
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Occurrence) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor14(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Occurrence) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor14(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Occurrence) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor14(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Occurrence) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor14(l, v)
+}
+func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor15(in *jlexer.Lexer, out *Node) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor15(out *jwriter.Writer, in Node) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix[1:])
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Node) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor15(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Node) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor15(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Node) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor15(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Node) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor15(l, v)
+}
+func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor16(in *jlexer.Lexer, out *Mapping) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Original":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Original).UnmarshalEasyJSON(in)
+			}
+		case "Generated":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Generated).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor16(out *jwriter.Writer, in Mapping) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Original\":"
+		out.RawString(prefix[1:])
+		(in.Original).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Generated\":"
+		out.RawString(prefix)
+		(in.Generated).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Mapping) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor16(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Mapping) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor16(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Mapping) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor16(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Mapping) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor16(l, v)
+}
+func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor17(in *jlexer.Lexer, out *Location) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Path":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Path = string(in.String())
+			}
+		case "Kind":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Kind = string(in.String())
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor17(out *jwriter.Writer, in Location) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Path\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Path))
+	}
+	{
+		const prefix string = ",\"Kind\":"
+		out.RawString(prefix)
+		out.String(string(in.Kind))
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Location) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor17(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Location) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor17(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Location) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor17(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Location) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor17(l, v)
+}
+func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor18(in *jlexer.Lexer, out *Lit) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "ValuePos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.ValuePos).UnmarshalEasyJSON(in)
+			}
+		case "ValueEnd":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.ValueEnd).UnmarshalEasyJSON(in)
+			}
+		case "Value":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Value = string(in.String())
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor18(out *jwriter.Writer, in Lit) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ValuePos\":"
+		out.RawString(prefix[1:])
+		(in.ValuePos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"ValueEnd\":"
+		out.RawString(prefix)
+		(in.ValueEnd).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Value\":"
+		out.RawString(prefix)
+		out.String(string(in.Value))
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Lit) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor18(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Lit) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor18(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Lit) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor18(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Lit) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor18(l, v)
+}
+func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor19(in *jlexer.Lexer, out *IncludeGraph) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Files":
+			if in.IsNull() {
+				in.Skip()
+				out.Files = nil
+			} else {
+				in.Delim('[')
+				if out.Files == nil {
+					if !in.IsDelim(']') {
+						out.Files = make([]IncludeFile, 0, 1)
+					} else {
+						out.Files = []IncludeFile{}
+					}
+				} else {
+					out.Files = (out.Files)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v67 IncludeFile
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v67).UnmarshalEasyJSON(in)
+					}
+					out.Files = append(out.Files, v67)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Unresolved":
+			if in.IsNull() {
+				in.Skip()
+				out.Unresolved = nil
+			} else {
+				in.Delim('[')
+				if out.Unresolved == nil {
+					if !in.IsDelim(']') {
+						out.Unresolved = make([]Include, 0, 0)
+					} else {
+						out.Unresolved = []Include{}
+					}
+				} else {
+					out.Unresolved = (out.Unresolved)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v68 Include
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v68).UnmarshalEasyJSON(in)
+					}
+					out.Unresolved = append(out.Unresolved, v68)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Cycles":
+			if in.IsNull() {
+				in.Skip()
+				out.Cycles = nil
+			} else {
+				in.Delim('[')
+				if out.Cycles == nil {
+					if !in.IsDelim(']') {
+						out.Cycles = make([][]string, 0, 2)
+					} else {
+						out.Cycles = [][]string{}
+					}
+				} else {
+					out.Cycles = (out.Cycles)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v69 []string
+					if in.IsNull() {
+						in.Skip()
+						v69 = nil
+					} else {
+						in.Delim('[')
+						if v69 == nil {
+							if !in.IsDelim(']') {
+								v69 = make([]string, 0, 4)
+							} else {
+								v69 = []string{}
+							}
+						} else {
+							v69 = (v69)[:0]
+						}
+						for !in.IsDelim(']') {
+							var v70 string
+							if in.IsNull() {
+								in.Skip()
+							} else {
+								v70 = string(in.String())
+							}
+							v69 = append(v69, v70)
+							in.WantComma()
+						}
+						in.Delim(']')
+					}
+					out.Cycles = append(out.Cycles, v69)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor19(out *jwriter.Writer, in IncludeGraph) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Files\":"
+		out.RawString(prefix[1:])
+		if in.Files == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v71, v72 := range in.Files {
+				if v71 > 0 {
+					out.RawByte(',')
+				}
+				(v72).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Unresolved\":"
+		out.RawString(prefix)
+		if in.Unresolved == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v73, v74 := range in.Unresolved {
+				if v73 > 0 {
+					out.RawByte(',')
+				}
+				(v74).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Cycles\":"
+		out.RawString(prefix)
+		if in.Cycles == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v75, v76 := range in.Cycles {
+				if v75 > 0 {
+					out.RawByte(',')
+				}
+				if v76 == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+					out.RawString("null")
+				} else {
+					out.RawByte('[')
+					for v77, v78 := range v76 {
+						if v77 > 0 {
+							out.RawByte(',')
+						}
+						out.String(string(v78))
+					}
+					out.RawByte(']')
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v IncludeGraph) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor19(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v IncludeGraph) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor19(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *IncludeGraph) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor19(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *IncludeGraph) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor19(l, v)
+}
+func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor20(in *jlexer.Lexer, out *IncludeFile) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Path":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Path = string(in.String())
+			}
+		case "Message":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Message = string(in.String())
+			}
+		case "Includes":
+			if in.IsNull() {
+				in.Skip()
+				out.Includes = nil
+			} else {
+				in.Delim('[')
+				if out.Includes == nil {
+					if !in.IsDelim(']') {
+						out.Includes = make([]Include, 0, 0)
+					} else {
+						out.Includes = []Include{}
+					}
+				} else {
+					out.Includes = (out.Includes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v79 Include
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v79).UnmarshalEasyJSON(in)
+					}
+					out.Includes = append(out.Includes, v79)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor20(out *jwriter.Writer, in IncludeFile) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Path\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Path))
+	}
+	{
+		const prefix string = ",\"Message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	{
+		const prefix string = ",\"Includes\":"
+		out.RawString(prefix)
+		if in.Includes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v80, v81 := range in.Includes {
+				if v80 > 0 {
+					out.RawByte(',')
+				}
+				(v81).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v IncludeFile) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor20(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v IncludeFile) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor20(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *IncludeFile) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor20(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *IncludeFile) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor20(l, v)
+}
+func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor21(in *jlexer.Lexer, out *Include) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "From":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.From = string(in.String())
+			}
+		case "To":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.To = string(in.String())
+			}
+		case "Text":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Text = string(in.String())
+			}
+		case "Message":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Message = string(in.String())
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor21(out *jwriter.Writer, in Include) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"From\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.From))
+	}
+	{
+		const prefix string = ",\"To\":"
+		out.RawString(prefix)
+		out.String(string(in.To))
+	}
+	{
+		const prefix string = ",\"Text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"Message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Include) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor21(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Include) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor21(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Include) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor21(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Include) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor21(l, v)
+}
+func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor22(in *jlexer.Lexer, out *Fix) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Message":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Message = string(in.String())
+			}
+		case "OldText":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.OldText = string(in.String())
+			}
+		case "NewText":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.NewText = string(in.String())
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor22(out *jwriter.Writer, in Fix) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Message\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Message))
+	}
+	{
+		const prefix string = ",\"OldText\":"
+		out.RawString(prefix)
+		out.String(string(in.OldText))
+	}
+	{
+		const prefix string = ",\"NewText\":"
+		out.RawString(prefix)
+		out.String(string(in.NewText))
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Fix) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor22(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Fix) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor22(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Fix) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor22(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Fix) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor22(l, v)
+}
+func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor23(in *jlexer.Lexer, out *File) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Name":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Name = string(in.String())
+			}
+		case "Stmts":
+			if in.IsNull() {
+				in.Skip()
+				out.Stmts = nil
+			} else {
+				in.Delim('[')
+				if out.Stmts == nil {
+					if !in.IsDelim(']') {
+						out.Stmts = make([]Stmt, 0, 0)
+					} else {
+						out.Stmts = []Stmt{}
+					}
+				} else {
+					out.Stmts = (out.Stmts)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v82 Stmt
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v82).UnmarshalEasyJSON(in)
+					}
+					out.Stmts = append(out.Stmts, v82)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Last":
+			if in.IsNull() {
+				in.Skip()
+				out.Last = nil
+			} else {
+				in.Delim('[')
+				if out.Last == nil {
+					if !in.IsDelim(']') {
+						out.Last = make([]Comment, 0, 0)
+					} else {
+						out.Last = []Comment{}
+					}
+				} else {
+					out.Last = (out.Last)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v83 Comment
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v83).UnmarshalEasyJSON(in)
+					}
+					out.Last = append(out.Last, v83)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor23(out *jwriter.Writer, in File) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"Stmts\":"
+		out.RawString(prefix)
+		if in.Stmts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v84, v85 := range in.Stmts {
+				if v84 > 0 {
+					out.RawByte(',')
+				}
+				(v85).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Last\":"
+		out.RawString(prefix)
+		if in.Last == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v86, v87 := range in.Last {
+				if v86 > 0 {
+					out.RawByte(',')
+				}
+				(v87).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v File) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor23(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v File) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor23(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *File) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor23(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *File) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor23(l, v)
+}
+func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor24(in *jlexer.Lexer, out *EnvironmentVariable) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Name":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Name = string(in.String())
+			}
+		case "Default":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Default = bool(in.Bool())
+			}
+		case "Required":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Required = bool(in.Bool())
+			}
+		case "Reads":
+			if in.IsNull() {
+				in.Skip()
+				out.Reads = nil
+			} else {
+				in.Delim('[')
+				if out.Reads == nil {
+					if !in.IsDelim(']') {
+						out.Reads = make([]EnvironmentRead, 0, 0)
+					} else {
+						out.Reads = []EnvironmentRead{}
+					}
+				} else {
+					out.Reads = (out.Reads)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v88 EnvironmentRead
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v88).UnmarshalEasyJSON(in)
+					}
+					out.Reads = append(out.Reads, v88)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor24(out *jwriter.Writer, in EnvironmentVariable) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"Default\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Default))
+	}
+	{
+		const prefix string = ",\"Required\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Required))
+	}
+	{
+		const prefix string = ",\"Reads\":"
+		out.RawString(prefix)
+		if in.Reads == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v89, v90 := range in.Reads {
+				if v89 > 0 {
+					out.RawByte(',')
+				}
+				(v90).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v EnvironmentVariable) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor24(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v EnvironmentVariable) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor24(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *EnvironmentVariable) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor24(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *EnvironmentVariable) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor24(l, v)
+}
+func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor25(in *jlexer.Lexer, out *EnvironmentRead) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "Kind":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Kind = string(in.String())
+			}
+		case "Operator":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Operator = string(in.String())
+			}
+		case "Default":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Default = string(in.String())
+			}
+		case "Pos":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Pos).UnmarshalEasyJSON(in)
+			}
+		case "End":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.End).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor25(out *jwriter.Writer, in EnvironmentRead) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Kind\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Kind))
+	}
+	{
+		const prefix string = ",\"Operator\":"
+		out.RawString(prefix)
+		out.String(string(in.Operator))
+	}
+	{
+		const prefix string = ",\"Default\":"
+		out.RawString(prefix)
+		out.String(string(in.Default))
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
+		(in.Pos).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"End\":"
+		out.RawString(prefix)
+		(in.End).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v EnvironmentRead) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor25(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v EnvironmentRead) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor25(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *EnvironmentRead) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor4(&r, v)
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor25(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Pos) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor4(l, v)
+func (v *EnvironmentRead) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor25(l, v)
 }
-func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor5(in *jlexer.Lexer, out *ParseError) {
+func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor26(in *jlexer.Lexer, out *EditorConfig) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -764,30 +4226,18 @@ func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor5(in *jlexer.Lexer, out
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "Pos":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				(out.Pos).UnmarshalEasyJSON(in)
-			}
-		case "Filename":
+		case "dir":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Filename = string(in.String())
+				out.Dir = string(in.String())
 			}
-		case "Text":
+		case "text":
 			if in.IsNull() {
 				in.Skip()
 			} else {
 				out.Text = string(in.String())
 			}
-		case "Incomplete":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Incomplete = bool(in.Bool())
-			}
 		default:
 			in.SkipRecursive()
 		}
@@ -798,57 +4248,47 @@ func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor5(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor5(out *jwriter.Writer, in ParseError) {
+func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor26(out *jwriter.Writer, in EditorConfig) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Pos\":"
+		const prefix string = ",\"dir\":"
 		out.RawString(prefix[1:])
-		(in.Pos).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"Filename\":"
-		out.RawString(prefix)
-		out.String(string(in.Filename))
+		out.String(string(in.Dir))
 	}
 	{
-		const prefix string = ",\"Text\":"
+		const prefix string = ",\"text\":"
 		out.RawString(prefix)
 		out.String(string(in.Text))
 	}
-	{
-		const prefix string = ",\"Incomplete\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Incomplete))
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ParseError) MarshalJSON() ([]byte, error) {
+func (v EditorConfig) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor5(&w, v)
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor26(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ParseError) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor5(w, v)
+func (v EditorConfig) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor26(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ParseError) UnmarshalJSON(data []byte) error {
+func (v *EditorConfig) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor5(&r, v)
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor26(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ParseError) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor5(l, v)
+func (v *EditorConfig) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor26(l, v)
 }
-func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor6(in *jlexer.Lexer, out *Node) {
+func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor27(in *jlexer.Lexer, out *Diagnostic) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -862,6 +4302,24 @@ func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor6(in *jlexer.Lexer, out
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
+		case "Rule":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Rule = string(in.String())
+			}
+		case "Severity":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Severity = string(in.String())
+			}
+		case "Message":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.Message = string(in.String())
+			}
 		case "Pos":
 			if in.IsNull() {
 				in.Skip()
@@ -874,6 +4332,20 @@ func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor6(in *jlexer.Lexer, out
 			} else {
 				(out.End).UnmarshalEasyJSON(in)
 			}
+		case "Fix":
+			if in.IsNull() {
+				in.Skip()
+				out.Fix = nil
+			} else {
+				if out.Fix == nil {
+					out.Fix = new(Fix)
+				}
+				if in.IsNull() {
+					in.Skip()
+				} else {
+					(*out.Fix).UnmarshalEasyJSON(in)
+				}
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -884,13 +4356,28 @@ func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor6(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor6(out *jwriter.Writer, in Node) {
+func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor27(out *jwriter.Writer, in Diagnostic) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Pos\":"
+		const prefix string = ",\"Rule\":"
 		out.RawString(prefix[1:])
+		out.String(string(in.Rule))
+	}
+	{
+		const prefix string = ",\"Severity\":"
+		out.RawString(prefix)
+		out.String(string(in.Severity))
+	}
+	{
+		const prefix string = ",\"Message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	{
+		const prefix string = ",\"Pos\":"
+		out.RawString(prefix)
 		(in.Pos).MarshalEasyJSON(out)
 	}
 	{
@@ -898,33 +4385,42 @@ func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor6(out *jwriter.Writer,
 		out.RawString(prefix)
 		(in.End).MarshalEasyJSON(out)
 	}
+	{
+		const prefix string = ",\"Fix\":"
+		out.RawString(prefix)
+		if in.Fix == nil {
+			out.RawString("null")
+		} else {
+			(*in.Fix).MarshalEasyJSON(out)
+		}
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Node) MarshalJSON() ([]byte, error) {
+func (v Diagnostic) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor6(&w, v)
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor27(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Node) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor6(w, v)
+func (v Diagnostic) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor27(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Node) UnmarshalJSON(data []byte) error {
+func (v *Diagnostic) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor6(&r, v)
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor27(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Node) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor6(l, v)
+func (v *Diagnostic) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor27(l, v)
 }
-func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor7(in *jlexer.Lexer, out *Lit) {
+func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor28(in *jlexer.Lexer, out *Comment) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -938,23 +4434,17 @@ func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor7(in *jlexer.Lexer, out
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "ValuePos":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				(out.ValuePos).UnmarshalEasyJSON(in)
-			}
-		case "ValueEnd":
+		case "Hash":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.ValueEnd).UnmarshalEasyJSON(in)
+				(out.Hash).UnmarshalEasyJSON(in)
 			}
-		case "Value":
+		case "Text":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Value = string(in.String())
+				out.Text = string(in.String())
 			}
 		case "Pos":
 			if in.IsNull() {
@@ -978,24 +4468,19 @@ func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor7(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor7(out *jwriter.Writer, in Lit) {
+func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor28(out *jwriter.Writer, in Comment) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"ValuePos\":"
+		const prefix string = ",\"Hash\":"
 		out.RawString(prefix[1:])
-		(in.ValuePos).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"ValueEnd\":"
-		out.RawString(prefix)
-		(in.ValueEnd).MarshalEasyJSON(out)
+		(in.Hash).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"Value\":"
+		const prefix string = ",\"Text\":"
 		out.RawString(prefix)
-		out.String(string(in.Value))
+		out.String(string(in.Text))
 	}
 	{
 		const prefix string = ",\"Pos\":"
@@ -1011,29 +4496,29 @@ func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor7(out *jwriter.Writer,
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Lit) MarshalJSON() ([]byte, error) {
+func (v Comment) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor7(&w, v)
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor28(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Lit) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor7(w, v)
+func (v Comment) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor28(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Lit) UnmarshalJSON(data []byte) error {
+func (v *Comment) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor7(&r, v)
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor28(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Lit) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor7(l, v)
+func (v *Comment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor28(l, v)
 }
-func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor8(in *jlexer.Lexer, out *File) {
+func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor29(in *jlexer.Lexer, out *CommandInventory) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1047,77 +4532,86 @@ func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor8(in *jlexer.Lexer, out
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "Name":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				out.Name = string(in.String())
-			}
-		case "Stmts":
+		case "Builtins":
 			if in.IsNull() {
 				in.Skip()
-				out.Stmts = nil
+				out.Builtins = nil
 			} else {
 				in.Delim('[')
-				if out.Stmts == nil {
+				if out.Builtins == nil {
 					if !in.IsDelim(']') {
-						out.Stmts = make([]Stmt, 0, 0)
+						out.Builtins = make([]Command, 0, 1)
 					} else {
-						out.Stmts = []Stmt{}
+						out.Builtins = []Command{}
 					}
 				} else {
-					out.Stmts = (out.Stmts)[:0]
+					out.Builtins = (out.Builtins)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v10 Stmt
+					var v91 Command
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v10).UnmarshalEasyJSON(in)
+						(v91).UnmarshalEasyJSON(in)
 					}
-					out.Stmts = append(out.Stmts, v10)
+					out.Builtins = append(out.Builtins, v91)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "Last":
+		case "Functions":
 			if in.IsNull() {
 				in.Skip()
-				out.Last = nil
+				out.Functions = nil
 			} else {
 				in.Delim('[')
-				if out.Last == nil {
+				if out.Functions == nil {
 					if !in.IsDelim(']') {
-						out.Last = make([]Comment, 0, 0)
+						out.Functions = make([]Command, 0, 1)
 					} else {
-						out.Last = []Comment{}
+						out.Functions = []Command{}
 					}
 				} else {
-					out.Last = (out.Last)[:0]
+					out.Functions = (out.Functions)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v11 Comment
+					var v92 Command
 					if in.IsNull() {
 						in.Skip()
 					} else {
-						(v11).UnmarshalEasyJSON(in)
+						(v92).UnmarshalEasyJSON(in)
 					}
-					out.Last = append(out.Last, v11)
+					out.Functions = append(out.Functions, v92)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "Pos":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				(out.Pos).UnmarshalEasyJSON(in)
-			}
-		case "End":
+		case "External":
 			if in.IsNull() {
 				in.Skip()
+				out.External = nil
 			} else {
-				(out.End).UnmarshalEasyJSON(in)
+				in.Delim('[')
+				if out.External == nil {
+					if !in.IsDelim(']') {
+						out.External = make([]Command, 0, 1)
+					} else {
+						out.External = []Command{}
+					}
+				} else {
+					out.External = (out.External)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v93 Command
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v93).UnmarshalEasyJSON(in)
+					}
+					out.External = append(out.External, v93)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -1129,84 +4623,85 @@ func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor8(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor8(out *jwriter.Writer, in File) {
+func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor29(out *jwriter.Writer, in CommandInventory) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Name\":"
+		const prefix string = ",\"Builtins\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"Stmts\":"
-		out.RawString(prefix)
-		if in.Stmts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Builtins == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v12, v13 := range in.Stmts {
-				if v12 > 0 {
+			for v94, v95 := range in.Builtins {
+				if v94 > 0 {
 					out.RawByte(',')
 				}
-				(v13).MarshalEasyJSON(out)
+				(v95).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
 	{
-		const prefix string = ",\"Last\":"
+		const prefix string = ",\"Functions\":"
 		out.RawString(prefix)
-		if in.Last == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Functions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v14, v15 := range in.Last {
-				if v14 > 0 {
+			for v96, v97 := range in.Functions {
+				if v96 > 0 {
 					out.RawByte(',')
 				}
-				(v15).MarshalEasyJSON(out)
+				(v97).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
 	{
-		const prefix string = ",\"Pos\":"
-		out.RawString(prefix)
-		(in.Pos).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"End\":"
+		const prefix string = ",\"External\":"
 		out.RawString(prefix)
-		(in.End).MarshalEasyJSON(out)
+		if in.External == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v98, v99 := range in.External {
+				if v98 > 0 {
+					out.RawByte(',')
+				}
+				(v99).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v File) MarshalJSON() ([]byte, error) {
+func (v CommandInventory) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor8(&w, v)
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor29(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v File) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor8(w, v)
+func (v CommandInventory) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor29(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *File) UnmarshalJSON(data []byte) error {
+func (v *CommandInventory) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor8(&r, v)
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor29(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *File) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor8(l, v)
+func (v *CommandInventory) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor29(l, v)
 }
-func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor9(in *jlexer.Lexer, out *Comment) {
+func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor30(in *jlexer.Lexer, out *Command) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1220,29 +4715,44 @@ func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor9(in *jlexer.Lexer, out
 		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		switch key {
-		case "Hash":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				(out.Hash).UnmarshalEasyJSON(in)
-			}
-		case "Text":
+		case "Name":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				out.Text = string(in.String())
+				out.Name = string(in.String())
 			}
-		case "Pos":
+		case "Count":
 			if in.IsNull() {
 				in.Skip()
 			} else {
-				(out.Pos).UnmarshalEasyJSON(in)
+				out.Count = int(in.Int())
 			}
-		case "End":
+		case "Calls":
 			if in.IsNull() {
 				in.Skip()
+				out.Calls = nil
 			} else {
-				(out.End).UnmarshalEasyJSON(in)
+				in.Delim('[')
+				if out.Calls == nil {
+					if !in.IsDelim(']') {
+						out.Calls = make([]Node, 0, 1)
+					} else {
+						out.Calls = []Node{}
+					}
+				} else {
+					out.Calls = (out.Calls)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v100 Node
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						(v100).UnmarshalEasyJSON(in)
+					}
+					out.Calls = append(out.Calls, v100)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -1254,53 +4764,59 @@ func easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor9(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor9(out *jwriter.Writer, in Comment) {
+func easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor30(out *jwriter.Writer, in Command) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Hash\":"
+		const prefix string = ",\"Name\":"
 		out.RawString(prefix[1:])
-		(in.Hash).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"Text\":"
-		out.RawString(prefix)
-		out.String(string(in.Text))
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"Pos\":"
+		const prefix string = ",\"Count\":"
 		out.RawString(prefix)
-		(in.Pos).MarshalEasyJSON(out)
+		out.Int(int(in.Count))
 	}
 	{
-		const prefix string = ",\"End\":"
+		const prefix string = ",\"Calls\":"
 		out.RawString(prefix)
-		(in.End).MarshalEasyJSON(out)
+		if in.Calls == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v101, v102 := range in.Calls {
+				if v101 > 0 {
+					out.RawByte(',')
+				}
+				(v102).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Comment) MarshalJSON() ([]byte, error) {
+func (v Command) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor9(&w, v)
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor30(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Comment) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor9(w, v)
+func (v Command) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a975c40EncodeGithubComUnTsShSyntaxProcessor30(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Comment) UnmarshalJSON(data []byte) error {
+func (v *Command) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor9(&r, v)
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor30(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Comment) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor9(l, v)
+func (v *Command) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a975c40DecodeGithubComUnTsShSyntaxProcessor30(l, v)
 }
@@ -0,0 +1,546 @@
+package processor
+
+import (
+	"slices"
+	"sort"
+	"strings"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+const (
+	symbolVariable = "variable"
+	symbolFunction = "function"
+)
+
+const (
+	scopeFile     = "file"
+	scopeFunction = "function"
+	scopeSubshell = "subshell"
+	scopeCmdSubst = "commandSubstitution"
+)
+
+// `symbolKey` identifies a symbol within a scope, as variables and functions have separate namespaces.
+type symbolKey struct {
+	kind string
+	name string
+}
+
+// `occurrence` is a use of the name of a symbol, between pos and end.
+type occurrence struct {
+	kind       string
+	definition bool
+	pos, end   syntax.Pos
+}
+
+type symbol struct {
+	symbolKey
+	scope       *scope
+	occurrences []occurrence
+}
+
+// `scope` is a region of a script whose variables and functions are not seen outside of it.
+type scope struct {
+	kind     string
+	name     string
+	node     syntax.Node
+	parent   *scope
+	children []*scope
+	symbols  []*symbol
+	index    map[symbolKey]*symbol
+	// calls holds the command names of the file scope which may call a function defined elsewhere, as
+	// no function of the script has their name.
+	calls []pendingCall
+	// declared holds the symbols declared in the scope so far while walking the script.
+	declared map[symbolKey]bool
+}
+
+func newScope(kind string, name string, node syntax.Node, parent *scope) *scope {
+	s := &scope{
+		kind:     kind,
+		name:     name,
+		node:     node,
+		parent:   parent,
+		index:    map[symbolKey]*symbol{},
+		declared: map[symbolKey]bool{},
+	}
+	if parent != nil {
+		parent.children = append(parent.children, s)
+	}
+	return s
+}
+
+// `symbol` returns the symbol of key in s, adding it if needed.
+func (s *scope) symbol(key symbolKey) *symbol {
+	sym, ok := s.index[key]
+	if !ok {
+		sym = &symbol{symbolKey: key, scope: s}
+		s.index[key] = sym
+		s.symbols = append(s.symbols, sym)
+	}
+	return sym
+}
+
+// `resolve` returns the innermost scope from s outwards where key is declared, or nil.
+func (s *scope) resolve(key symbolKey) *scope {
+	for ; s != nil; s = s.parent {
+		if s.declared[key] {
+			return s
+		}
+	}
+	return nil
+}
+
+// `assignScope` returns the scope a plain assignment to key in s defines it in: the innermost scope
+// around s where it is declared, unless a subshell or command substitution comes first, whose
+// assignments are lost once it exits, else the file.
+func (s *scope) assignScope(key symbolKey) *scope {
+	for ; s.parent != nil; s = s.parent {
+		if s.declared[key] || s.kind == scopeSubshell || s.kind == scopeCmdSubst {
+			return s
+		}
+	}
+	return s
+}
+
+// `symbolTable` records the scopes and symbols of a script while walking it with syntax.Walk.
+type symbolTable struct {
+	file *scope
+	// current is the innermost scope of the node being walked.
+	current *scope
+	// pending holds the declarations made by an assignment, which only take effect once its value
+	// has been expanded, as in `local a=$a`.
+	pending map[syntax.Node][]pendingDeclaration
+	// calls holds the command names which may call a function, resolved once every function is known.
+	calls []pendingCall
+}
+
+type pendingDeclaration struct {
+	scope *scope
+	key   symbolKey
+}
+
+type pendingCall struct {
+	scope *scope
+	kind  string
+	name  *syntax.Word
+}
+
+// `buildSymbols` returns the scope tree of file, with the symbols defined and referenced in each scope.
+//
+// Scoping is approximated statically, in the order of the script: `local`, `declare` and `typeset`
+// declare a variable in the function around them, any assignment within a subshell or command
+// substitution stays within it, and other variables and functions are global. A variable which is
+// only read, such as one from the environment, is global, and a command name is only a reference to a
+// function defined in the script.
+func buildSymbols(file *syntax.File) *scope {
+	st := &symbolTable{
+		file:    newScope(scopeFile, "", file, nil),
+		pending: map[syntax.Node][]pendingDeclaration{},
+	}
+	st.current = st.file
+
+	var stack []syntax.Node
+
+	syntax.Walk(file, func(node syntax.Node) bool {
+		if node == nil {
+			node, stack = stack[len(stack)-1], stack[:len(stack)-1]
+			for _, d := range st.pending[node] {
+				d.scope.declared[d.key] = true
+			}
+			delete(st.pending, node)
+			if st.current.node == node {
+				st.current = st.current.parent
+			}
+			return true
+		}
+
+		stack = append(stack, node)
+		st.visit(node)
+
+		return true
+	})
+
+	for _, call := range st.calls {
+		key := symbolKey{symbolFunction, call.name.Lit()}
+		if declaring := call.scope.resolve(key); declaring != nil {
+			st.reference(declaring, key, call.kind, call.name)
+		} else if functionNameRegexp.MatchString(key.name) {
+			st.file.calls = append(st.file.calls, call)
+		}
+	}
+
+	st.file.sort()
+
+	return st.file
+}
+
+func (st *symbolTable) visit(node syntax.Node) {
+	switch node := node.(type) {
+	case *syntax.FuncDecl:
+		if node.Name != nil {
+			key := symbolKey{symbolFunction, node.Name.Value}
+			st.define(st.current.assignScope(key), key, "function", node.Name, nil)
+		}
+		name := ""
+		if node.Name != nil {
+			name = node.Name.Value
+		}
+		st.current = newScope(scopeFunction, name, node, st.current)
+	case *syntax.Subshell:
+		st.current = newScope(scopeSubshell, "", node, st.current)
+	case *syntax.CmdSubst:
+		st.current = newScope(scopeCmdSubst, "", node, st.current)
+	case *syntax.CallExpr:
+		for _, assign := range node.Assigns {
+			st.assign("assign", assign, false)
+		}
+		st.command(node.Args)
+	case *syntax.DeclClause:
+		st.declClause(node)
+	case *syntax.ForClause:
+		if wi, ok := node.Loop.(*syntax.WordIter); ok {
+			kind := "for"
+			if node.Select {
+				kind = "select"
+			}
+			st.assignName(kind, wi.Name, wi)
+		}
+		if cl, ok := node.Loop.(*syntax.CStyleLoop); ok {
+			st.arithm(cl.Init)
+			st.arithm(cl.Cond)
+			st.arithm(cl.Post)
+		}
+	case *syntax.ParamExp:
+		if node.Param != nil && node.Names == 0 && syntax.ValidName(node.Param.Value) {
+			st.read(symbolKey{symbolVariable, node.Param.Value}, "expansion", node.Param)
+		}
+		st.arithm(node.Index)
+		if node.Slice != nil {
+			st.arithm(node.Slice.Offset)
+			st.arithm(node.Slice.Length)
+		}
+	case *syntax.ArithmExp:
+		st.arithm(node.X)
+	case *syntax.ArithmCmd:
+		st.arithm(node.X)
+	case *syntax.LetClause:
+		for _, x := range node.Exprs {
+			st.arithm(x)
+		}
+	}
+}
+
+// `define` adds a definition of key to the symbol of s, at node, declaring it right away, or once
+// pending is walked if it is not nil.
+func (st *symbolTable) define(s *scope, key symbolKey, kind string, node syntax.Node, pending syntax.Node) {
+	sym := s.symbol(key)
+	sym.occurrences = append(sym.occurrences, occurrence{kind: kind, definition: true, pos: node.Pos(), end: node.End()})
+	if pending != nil {
+		st.pending[pending] = append(st.pending[pending], pendingDeclaration{s, key})
+	} else {
+		s.declared[key] = true
+	}
+}
+
+func (st *symbolTable) reference(s *scope, key symbolKey, kind string, node syntax.Node) {
+	sym := s.symbol(key)
+	sym.occurrences = append(sym.occurrences, occurrence{kind: kind, pos: node.Pos(), end: node.End()})
+}
+
+// `read` adds a reference to the variable or function key from the current scope.
+func (st *symbolTable) read(key symbolKey, kind string, node syntax.Node) {
+	declaring := st.current.resolve(key)
+	if declaring == nil {
+		declaring = st.file
+	}
+	st.reference(declaring, key, kind, node)
+}
+
+// `assign` adds the definition made by assign, declaring it in the current scope if local is set.
+func (st *symbolTable) assign(kind string, assign *syntax.Assign, local bool) {
+	if assign.Name == nil {
+		return
+	}
+	key := symbolKey{symbolVariable, assign.Name.Value}
+	declaring := st.current.assignScope(key)
+	if local {
+		declaring = st.current
+	}
+	st.define(declaring, key, kind, assign.Name, assign)
+	st.arithm(assign.Index)
+}
+
+// `assignName` adds a plain assignment of the variable named by node, declared right away, or once
+// pending is walked if it is not nil.
+func (st *symbolTable) assignName(kind string, node syntax.Node, pending syntax.Node) {
+	var name string
+	switch node := node.(type) {
+	case *syntax.Lit:
+		name = node.Value
+	case *syntax.Word:
+		name = node.Lit()
+	}
+	if !syntax.ValidName(name) {
+		return
+	}
+	key := symbolKey{symbolVariable, name}
+	st.define(st.current.assignScope(key), key, kind, node, pending)
+}
+
+// `declClause` adds the definitions or references made by a `declare`, `local`, `typeset`, `export`
+// or `readonly` clause. Without a value, the latter two only refer to a variable defined elsewhere, as
+// do the names printed with `-p`.
+func (st *symbolTable) declClause(dc *syntax.DeclClause) {
+	variant := dc.Variant.Value
+	local := variant == "local" || variant == "declare" || variant == "typeset"
+	print := false
+
+	for _, assign := range dc.Args {
+		if assign.Name != nil || assign.Value == nil {
+			continue
+		}
+		flags := assign.Value.Lit()
+		if !strings.HasPrefix(flags, "-") {
+			continue
+		}
+		if strings.ContainsAny(flags, "fF") {
+			// `declare -f` refers to functions, which are not followed.
+			return
+		}
+		if strings.Contains(flags, "g") {
+			local = false
+		}
+		if strings.Contains(flags, "p") {
+			print = true
+		}
+	}
+
+	for _, assign := range dc.Args {
+		switch {
+		case assign.Name == nil:
+		case assign.Naked && (print || variant == "export" || variant == "readonly"):
+			st.read(symbolKey{symbolVariable, assign.Name.Value}, variant, assign.Name)
+		default:
+			st.assign(variant, assign, local && st.current != st.file)
+		}
+	}
+}
+
+// readOptions are the options of `read` taking a value, besides `-a` which takes the name of an array.
+const readOptions = "dinNptu"
+
+// `command` adds the references and definitions made by a simple command: a call of a function, the
+// variables assigned by `read`, `mapfile`, `readarray` and `getopts`, and those removed by `unset`.
+func (st *symbolTable) command(args []*syntax.Word) {
+	if len(args) == 0 {
+		return
+	}
+
+	name := args[0].Lit()
+	if name == "" {
+		return
+	}
+
+	st.calls = append(st.calls, pendingCall{st.current, "call", args[0]})
+
+	args = args[1:]
+
+	switch name {
+	case "read":
+		for i := 0; i < len(args); i++ {
+			arg := args[i].Lit()
+			switch {
+			case arg == "--":
+			case strings.HasPrefix(arg, "-") && len(arg) > 1:
+				if opt := arg[len(arg)-1]; strings.IndexByte(readOptions+"a", opt) >= 0 && i+1 < len(args) {
+					i++
+					if opt == 'a' {
+						st.assignName(name, args[i], nil)
+					}
+				}
+			default:
+				st.assignName(name, args[i], nil)
+			}
+		}
+	case "mapfile", "readarray":
+		if len(args) > 0 && !strings.HasPrefix(args[len(args)-1].Lit(), "-") {
+			st.assignName(name, args[len(args)-1], nil)
+		}
+	case "getopts":
+		if len(args) > 1 {
+			st.assignName(name, args[1], nil)
+		}
+	case "unset":
+		kind := symbolVariable
+		for _, arg := range args {
+			lit := arg.Lit()
+			switch {
+			case lit == "-f":
+				kind = symbolFunction
+			case lit == "-v" || lit == "--":
+			case syntax.ValidName(lit):
+				if kind == symbolFunction {
+					st.calls = append(st.calls, pendingCall{st.current, "unset", arg})
+				} else {
+					st.read(symbolKey{kind, lit}, "unset", arg)
+				}
+			}
+		}
+	}
+}
+
+// `arithm` adds the variables read and assigned by the arithmetic expression x. Parameter expansions
+// within it are left to the walk of the script.
+func (st *symbolTable) arithm(x syntax.ArithmExpr) {
+	if x == nil {
+		return
+	}
+
+	assigned := map[*syntax.Word]bool{}
+
+	syntax.Walk(x, func(node syntax.Node) bool {
+		switch node := node.(type) {
+		case *syntax.BinaryArithm:
+			if word, ok := node.X.(*syntax.Word); ok && (node.Op == syntax.Assgn || (node.Op >= syntax.AddAssgn && node.Op <= syntax.PowAssgn)) {
+				st.assignName("arithmetic", word, nil)
+				assigned[word] = true
+			}
+		case *syntax.UnaryArithm:
+			if word, ok := node.X.(*syntax.Word); ok && (node.Op == syntax.Inc || node.Op == syntax.Dec) {
+				st.assignName("arithmetic", word, nil)
+				assigned[word] = true
+			}
+		case *syntax.ParenArithm, nil:
+		case *syntax.Word:
+			if name := node.Lit(); !assigned[node] && syntax.ValidName(name) {
+				st.read(symbolKey{symbolVariable, name}, "arithmetic", node)
+			}
+			return false
+		default:
+			return false
+		}
+		return true
+	})
+}
+
+// `sort` orders the occurrences of every symbol of s and its children by position.
+func (s *scope) sort() {
+	for _, sym := range s.symbols {
+		sort.SliceStable(sym.occurrences, func(i, j int) bool {
+			return sym.occurrences[i].pos.Offset() < sym.occurrences[j].pos.Offset()
+		})
+	}
+	sort.SliceStable(s.symbols, func(i, j int) bool {
+		return s.symbols[i].occurrences[0].pos.Offset() < s.symbols[j].occurrences[0].pos.Offset()
+	})
+	for _, child := range s.children {
+		child.sort()
+	}
+}
+
+// `mapScope` converts s into a Scope, moving every position with pos.
+func mapScope(s *scope, pos func(Pos) Pos) Scope {
+	result := Scope{
+		Kind:     s.kind,
+		Name:     s.name,
+		Pos:      pos(mapPos(s.node.Pos())),
+		End:      pos(mapPos(s.node.End())),
+		Symbols:  make([]Symbol, 0, len(s.symbols)),
+		Children: make([]Scope, 0, len(s.children)),
+	}
+
+	for _, sym := range s.symbols {
+		result.Symbols = append(result.Symbols, mapSymbol(sym, pos))
+	}
+
+	for _, child := range s.children {
+		result.Children = append(result.Children, mapScope(child, pos))
+	}
+
+	return result
+}
+
+// `mapSymbol` converts sym into a Symbol, moving every position with pos.
+func mapSymbol(sym *symbol, pos func(Pos) Pos) Symbol {
+	symbol := Symbol{Name: sym.name, Kind: sym.kind, Definitions: []Occurrence{}, References: []Occurrence{}}
+
+	for _, o := range sym.occurrences {
+		occurrence := Occurrence{Kind: o.kind, Pos: pos(mapPos(o.pos)), End: pos(mapPos(o.end))}
+		if o.definition {
+			symbol.Definitions = append(symbol.Definitions, occurrence)
+		} else {
+			symbol.References = append(symbol.References, occurrence)
+		}
+	}
+
+	return symbol
+}
+
+// `find` returns the first symbol of s or of the scopes within it with an occurrence matching match.
+func (s *scope) find(match func(o occurrence) bool) *symbol {
+	for _, sym := range s.symbols {
+		if slices.ContainsFunc(sym.occurrences, match) {
+			return sym
+		}
+	}
+	for _, child := range s.children {
+		if sym := child.find(match); sym != nil {
+			return sym
+		}
+	}
+	return nil
+}
+
+// `Symbols` parses originalText and returns its scope tree: the file, and within it the function
+// bodies, subshells and command substitutions, each with the variables and functions it holds and the
+// ranges of their names where they are defined and referenced, as approximated by buildSymbols.
+// Every position points into originalText as it is, as with Print.
+func Symbols(originalText string, filepath string, parserOptions ParserOptions) (Scope, error) {
+	text, original := normalizeLineEndings(originalText)
+
+	file, err := Parse(text, filepath, parserOptions)
+
+	if err != nil {
+		return Scope{}, original.err(err)
+	}
+
+	return mapScope(buildSymbols(file), original.pos), nil
+}
+
+// `at` returns the symbol of s or of the scopes within it whose name is at offset, a byte offset into
+// the text normalized into original. A name starting at offset wins over one ending there, as in `$a$b`.
+func (s *scope) at(offset uint, original lineEndings) *symbol {
+	sym := s.find(func(o occurrence) bool {
+		return original.pos(mapPos(o.pos)).Offset <= offset && offset < original.pos(mapPos(o.end)).Offset
+	})
+	if sym == nil {
+		sym = s.find(func(o occurrence) bool {
+			return original.pos(mapPos(o.end)).Offset == offset
+		})
+	}
+	return sym
+}
+
+// `SymbolAt` parses originalText and returns the variable or function whose name is at offset, a byte
+// offset into originalText, with the ranges of all of its definitions and references as resolved by
+// buildSymbols, or nil if there is none. An offset right after a name is within it, as a cursor would be.
+func SymbolAt(originalText string, filepath string, parserOptions ParserOptions, offset uint) (*Symbol, error) {
+	text, original := normalizeLineEndings(originalText)
+
+	file, err := Parse(text, filepath, parserOptions)
+
+	if err != nil {
+		return nil, original.err(err)
+	}
+
+	sym := buildSymbols(file).at(offset, original)
+
+	if sym == nil {
+		return nil, nil
+	}
+
+	symbol := mapSymbol(sym, original.pos)
+
+	return &symbol, nil
+}
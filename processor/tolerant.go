@@ -0,0 +1,235 @@
+package processor
+
+import (
+	"strings"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// `shift` moves positions relative to the start of a chunk of text to positions relative to the whole
+// text. Chunks always start at the beginning of a line, so columns are left as they are.
+type shift struct {
+	offset uint
+	line   uint
+}
+
+func (s shift) pos(pos Pos) Pos {
+	if pos.Line == 0 {
+		return pos
+	}
+	return Pos{Offset: pos.Offset + s.offset, Line: pos.Line + s.line, Col: pos.Col}
+}
+
+// `move` moves the positions of r in the original text with original and the positions in the
+// formatted text with generated.
+func (r *Report) move(original func(Pos) Pos, generated func(Pos) Pos) {
+	for i, s := range r.Simplifications {
+		r.Simplifications[i].Pos, r.Simplifications[i].End = original(s.Pos), original(s.End)
+	}
+	for i, f := range r.Fixes {
+		r.Fixes[i].Pos, r.Fixes[i].End = original(f.Pos), original(f.End)
+	}
+	for i, m := range r.SourceMap {
+		r.SourceMap[i] = Mapping{
+			Original:  Node{Pos: original(m.Original.Pos), End: original(m.Original.End)},
+			Generated: Node{Pos: generated(m.Generated.Pos), End: generated(m.Generated.End)},
+		}
+	}
+	for i, w := range r.Warnings {
+		r.Warnings[i].Pos, r.Warnings[i].End = original(w.Pos), original(w.End)
+	}
+	for i, e := range r.ParseErrors {
+		r.ParseErrors[i].Pos = original(e.Pos)
+	}
+}
+
+// `merge` appends the report of a chunk to r, moving the positions in the original text by original
+// and the positions in the formatted text by generated.
+func (r *Report) merge(other Report, original shift, generated shift) {
+	other.move(original.pos, generated.pos)
+
+	r.Simplifications = append(r.Simplifications, other.Simplifications...)
+	r.Fixes = append(r.Fixes, other.Fixes...)
+	r.SourceMap = append(r.SourceMap, other.SourceMap...)
+	r.Warnings = append(r.Warnings, other.Warnings...)
+}
+
+// `printTolerant` formats the parts of originalText which can be parsed and copies the other ones
+// through unchanged, reporting every parse error met along the way.
+//
+// The text is split into chunks of whole lines. The top-level statements parsed before an error are
+// formatted, and the lines from the failing statement up to the error are kept verbatim, or up to
+// the end of the text if the error is about the input ending too early, like an unclosed quote.
+// Parsing then resumes on the next line. Blank lines around verbatim regions are kept, as the
+// printer would otherwise drop them at the edges of each chunk. A region which is parsed but cannot be
+// printed, such as one failing Verify, is kept verbatim as well, with a warning holding the error.
+//
+// The RecoverErrors parser option is not used on purpose: it only recovers from a few missing tokens,
+// such as a `fi` or `done` at the end of the input, by inserting them into the tree, so printing a
+// recovered tree would rewrite the broken region instead of keeping it verbatim, and any other error
+// still stops the parser.
+func printTolerant(originalText string, filepath string, syntaxOptions SyntaxOptions) (string, Report, error) {
+	syntaxOptions.Tolerant = false
+
+	report := Report{ParseErrors: []ParseError{}}
+
+	var out strings.Builder
+	var start, skippedLines uint
+
+	for start < uint(len(originalText)) {
+		chunk := originalText[start:]
+		original := shift{offset: start, line: skippedLines}
+
+		if start > 0 && blankLine(chunk) {
+			out.WriteByte('\n')
+		}
+
+		verbatimFrom, verbatimTo, parseError := splitChunk(chunk, filepath, syntaxOptions.ParserOptions)
+
+		prefix := chunk[:verbatimFrom]
+
+		if strings.TrimSpace(prefix) != "" {
+			generated := shift{offset: uint(out.Len()), line: uint(strings.Count(out.String(), "\n"))}
+			text, chunkReport, err := printOnce(prefix, filepath, syntaxOptions)
+
+			if err == nil {
+				report.merge(chunkReport, original, generated)
+				out.WriteString(text)
+
+				if parseError != nil && strings.HasSuffix(prefix, "\n\n") {
+					out.WriteByte('\n')
+				}
+			} else {
+				report.Warnings = append(report.Warnings, Warning{
+					Message: err.Error(),
+					Text:    prefix,
+					Pos:     original.pos(Pos{Line: 1, Col: 1}),
+					End:     original.pos(endPos(prefix)),
+				})
+				out.WriteString(prefix)
+			}
+		}
+
+		if parseError == nil {
+			break
+		}
+
+		pos := parseError.Pos
+		parseError.Pos = syntax.NewPos(pos.Offset()+start, pos.Line()+skippedLines, pos.Col())
+		mapped, _ := MapParseError(*parseError)
+		report.ParseErrors = append(report.ParseErrors, *mapped)
+
+		out.WriteString(chunk[verbatimFrom:verbatimTo])
+
+		skippedLines += uint(strings.Count(chunk[:verbatimTo], "\n"))
+		start += verbatimTo
+	}
+
+	return out.String(), report, nil
+}
+
+// `splitChunk` parses the top-level statements of chunk until the first error, and returns the range
+// of whole lines to keep verbatim along with that error, or the whole chunk and no error if it can be
+// parsed. Errors which are not syntax errors are not expected from a string reader.
+func splitChunk(chunk string, filepath string, parserOptions ParserOptions) (uint, uint, *syntax.ParseError) {
+	var stmts []*syntax.Stmt
+	var parseError *syntax.ParseError
+
+	for stmt, err := range newParser(parserOptions).StmtsSeq(strings.NewReader(chunk)) {
+		if err != nil {
+			if pe, ok := err.(syntax.ParseError); ok {
+				parseError = &pe
+			}
+			break
+		}
+		stmts = append(stmts, stmt)
+	}
+
+	if parseError == nil {
+		return uint(len(chunk)), uint(len(chunk)), nil
+	}
+
+	parseError.Filename = filepath
+
+	// The failing statement starts after everything consumed by the last complete one,
+	// including its heredoc bodies and trailing comments.
+	var consumed uint
+	if len(stmts) > 0 {
+		syntax.Walk(stmts[len(stmts)-1], func(node syntax.Node) bool {
+			if node != nil && node.End().IsValid() && node.End().Offset() > consumed {
+				consumed = node.End().Offset()
+			}
+			return true
+		})
+	}
+	failing := skipSeparators(chunk, consumed)
+
+	// Keep whole lines, so a complete statement sharing a line with the failing one is kept verbatim
+	// too, and so is any statement ending on that line.
+	from := lineStart(chunk, min(failing, parseError.Pos.Offset()))
+	for i := len(stmts) - 1; i >= 0; i-- {
+		if stmts[i].End().Offset() > from {
+			from = min(from, lineStart(chunk, stmts[i].Pos().Offset()))
+		}
+	}
+
+	to := uint(len(chunk))
+	if !parseError.Incomplete {
+		to = lineEnd(chunk, max(parseError.Pos.Offset(), from))
+	}
+
+	return from, to, parseError
+}
+
+// `skipSeparators` returns the offset of the first byte of text from offset on which is not a blank,
+// a statement separator, an escaped newline or part of a comment.
+func skipSeparators(text string, offset uint) uint {
+	for offset < uint(len(text)) {
+		switch text[offset] {
+		case ' ', '\t', '\r', '\n', ';', '&':
+			offset++
+		case '\\':
+			if offset+1 < uint(len(text)) && text[offset+1] == '\n' {
+				offset += 2
+			} else {
+				return offset
+			}
+		case '#':
+			offset = lineEnd(text, offset)
+		default:
+			return offset
+		}
+	}
+	return offset
+}
+
+// `lineStart` returns the offset of the beginning of the line containing offset.
+func lineStart(text string, offset uint) uint {
+	return uint(strings.LastIndexByte(text[:min(offset, uint(len(text)))], '\n') + 1)
+}
+
+// `lineEnd` returns the offset right after the newline ending the line containing offset.
+func lineEnd(text string, offset uint) uint {
+	if offset >= uint(len(text)) {
+		return uint(len(text))
+	}
+	if i := strings.IndexByte(text[offset:], '\n'); i >= 0 {
+		return offset + uint(i) + 1
+	}
+	return uint(len(text))
+}
+
+// `endPos` returns the position right after the end of text, relative to its start.
+func endPos(text string) Pos {
+	return Pos{
+		Offset: uint(len(text)),
+		Line:   uint(strings.Count(text, "\n")) + 1,
+		Col:    uint(len(text)-strings.LastIndexByte(text, '\n')-1) + 1,
+	}
+}
+
+// `blankLine` reports whether text starts with a line made of blanks only.
+func blankLine(text string) bool {
+	line := text[:lineEnd(text, 0)]
+	return len(line) > 0 && strings.TrimSpace(line) == ""
+}
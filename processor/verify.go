@@ -0,0 +1,163 @@
+package processor
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+var (
+	posType      = reflect.TypeOf(syntax.Pos{})
+	commentType  = reflect.TypeOf(syntax.Comment{})
+	commentsType = reflect.TypeOf([]syntax.Comment(nil))
+	nodeType     = reflect.TypeOf((*syntax.Node)(nil)).Elem()
+	sglQuoteType = reflect.TypeOf(syntax.SglQuoted{})
+)
+
+// `ignoredFields` returns the fields which only affect how a node is written, which the printer is free
+// to change with printerOptions.
+func ignoredFields(printerOptions PrinterOptions) map[reflect.Type][]string {
+	fields := map[reflect.Type][]string{
+		reflect.TypeOf(syntax.CmdSubst{}): {"Backquotes"},
+	}
+	if printerOptions.Minify || printerOptions.BraceParams {
+		fields[reflect.TypeOf(syntax.ParamExp{})] = []string{"Short"}
+	}
+	if printerOptions.FunctionStyle != FunctionStyleKeep {
+		fields[reflect.TypeOf(syntax.FuncDecl{})] = []string{"RsrvWord", "Parens"}
+	}
+	return fields
+}
+
+// `VerifyError` is returned by Print when Verify is set and the formatted script does not parse into
+// the same syntax tree as the one which was printed.
+type VerifyError struct {
+	Node      string
+	Field     string
+	Original  Pos
+	Formatted Pos
+}
+
+func (e VerifyError) Error() string {
+	return fmt.Sprintf(
+		"formatted script is not equivalent to the original: %s at %d:%d (formatted %d:%d) differs in %s",
+		e.Node, e.Original.Line, e.Original.Col, e.Formatted.Line, e.Formatted.Col, e.Field,
+	)
+}
+
+// `verify` compares formatted, the syntax tree of the formatted output, with file, the tree of the
+// original script, returning a VerifyError for the first node which differs.
+//
+// Positions are ignored, and so are comments, as the printer may move them around without changing
+// the behaviour of the script. So are ignoredFields: backquoted command substitutions are always
+// printed as `$(...)`, Minify and BraceParams drop or add the braces of parameter expansions which
+// do not need them, and FunctionStyle rewrites function declarations. The other rewrites of the
+// quote style options are only expected when they are set: SingleQuote prints a double-quoted string
+// without any expansion single-quoted, and PlainAnsiCQuotes a `$'...'` string without any escape
+// sequence as a plain single-quoted one. Any other difference is reported, so a printer option
+// changing more than it should is caught.
+func verify(file *syntax.File, formatted *syntax.File, printerOptions PrinterOptions) error {
+	v := verifier{
+		original:         file,
+		formatted:        formatted,
+		ignored:          ignoredFields(printerOptions),
+		singleQuote:      printerOptions.SingleQuote,
+		plainAnsiCQuotes: printerOptions.PlainAnsiCQuotes,
+	}
+
+	if v.equal(reflect.ValueOf(file), reflect.ValueOf(formatted)) {
+		return nil
+	}
+
+	return VerifyError{
+		Node:      nodeName(v.original),
+		Field:     strings.Join(v.fields, "."),
+		Original:  mapPos(v.original.Pos()),
+		Formatted: mapPos(v.formatted.Pos()),
+	}
+}
+
+// `verifier` compares two syntax trees, keeping track of the innermost nodes being compared and of
+// the fields leading from them to the first difference.
+type verifier struct {
+	original  syntax.Node
+	formatted syntax.Node
+	fields    []string
+
+	ignored          map[reflect.Type][]string
+	singleQuote      bool
+	plainAnsiCQuotes bool
+}
+
+func (v *verifier) equal(x, y reflect.Value) bool {
+	switch x.Kind() {
+	case reflect.Interface:
+		if x.IsNil() || y.IsNil() {
+			return x.IsNil() == y.IsNil()
+		}
+		if x.Elem().Type() != y.Elem().Type() {
+			// SingleQuote prints double-quoted strings without any expansion in single quotes.
+			if part, ok := x.Interface().(syntax.WordPart); ok && v.singleQuote {
+				if sq := singleQuoted(part); sq != nil {
+					return v.equal(reflect.ValueOf(sq), y.Elem())
+				}
+			}
+			return false
+		}
+		return v.equal(x.Elem(), y.Elem())
+	case reflect.Ptr:
+		if x.IsNil() || y.IsNil() {
+			return x.IsNil() == y.IsNil()
+		}
+		if x.Type().Implements(nodeType) && x.CanInterface() {
+			original, formatted, fields := v.original, v.formatted, v.fields
+			v.original, v.formatted, v.fields = x.Interface().(syntax.Node), y.Interface().(syntax.Node), nil
+			if !v.equal(x.Elem(), y.Elem()) {
+				return false
+			}
+			v.original, v.formatted, v.fields = original, formatted, fields
+			return true
+		}
+		return v.equal(x.Elem(), y.Elem())
+	case reflect.Struct:
+		for i := 0; i < x.NumField(); i++ {
+			field := x.Type().Field(i)
+			if field.Type == posType || field.Type == commentType || field.Type == commentsType ||
+				slices.Contains(v.ignored[x.Type()], field.Name) {
+				continue
+			}
+			// PlainAnsiCQuotes drops the `$` of `$'...'` strings without any escape sequence.
+			if v.plainAnsiCQuotes && x.Type() == sglQuoteType && field.Name == "Dollar" && !strings.Contains(x.FieldByName("Value").String(), "\\") {
+				continue
+			}
+			v.fields = append(v.fields, field.Name)
+			if !v.equal(x.Field(i), y.Field(i)) {
+				return false
+			}
+			v.fields = v.fields[:len(v.fields)-1]
+		}
+		return true
+	case reflect.Slice:
+		if x.Len() != y.Len() {
+			return false
+		}
+		for i := 0; i < x.Len(); i++ {
+			if !v.equal(x.Index(i), y.Index(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Bool:
+		return x.Bool() == y.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return x.Int() == y.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return x.Uint() == y.Uint()
+	case reflect.String:
+		return x.String() == y.String()
+	}
+	return true
+}
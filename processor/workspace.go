@@ -0,0 +1,342 @@
+package processor
+
+import (
+	"fmt"
+	"sort"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// `Workspace` holds the documents of several scripts, such as the files open in an editor, to resolve
+// the variables and functions they share by including each other with `source` or `.`.
+//
+// The documents including each other, directly or not and in either direction, are run by the same
+// shell, so the global variables and functions of one of them are those of the others. The symbols
+// within functions, subshells and command substitutions stay local to their document.
+type Workspace struct {
+	documents map[string]*document
+	// definitions indexes the paths of the documents defining every global variable and function.
+	definitions map[symbolKey]map[string]bool
+	// references indexes the paths of the documents using every global variable and function without
+	// defining it.
+	references map[symbolKey]map[string]bool
+	// components numbers the parsed documents, those sharing their global symbols having the same number.
+	components map[string]int
+}
+
+// `document` is a script of a workspace, along with its syntax tree and symbols once parsed.
+type document struct {
+	path     string
+	original lineEndings
+	file     *syntax.File
+	// err is the error parsing the document, if any, in which case it has no symbols.
+	err      error
+	scope    *scope
+	includes []includeTarget
+}
+
+func NewWorkspace() *Workspace {
+	return &Workspace{
+		documents:   map[string]*document{},
+		definitions: map[symbolKey]map[string]bool{},
+		references:  map[symbolKey]map[string]bool{},
+		components:  map[string]int{},
+	}
+}
+
+// `Update` parses originalText as the document at filepath, adding it to w or replacing its previous
+// text, and indexes its global symbols. Only this document is parsed again, while the others are
+// kept as they are. An error is returned if it cannot be parsed, in which case the document is kept
+// without any symbol until it is updated again.
+func (w *Workspace) Update(filepath string, originalText string, parserOptions ParserOptions) error {
+	filepath = cleanPath(filepath)
+
+	w.remove(filepath)
+	defer w.link()
+
+	text, original := normalizeLineEndings(originalText)
+
+	file, err := Parse(text, filepath, parserOptions)
+
+	doc := &document{path: filepath, original: original}
+	w.documents[filepath] = doc
+
+	if err != nil {
+		doc.err = original.err(err)
+		return doc.err
+	}
+
+	doc.file = file
+	doc.scope = buildSymbols(file)
+
+	_, targets := collectIncludes(file, text, original, filepath)
+
+	for _, target := range targets {
+		if target.path != "" {
+			doc.includes = append(doc.includes, target)
+		}
+	}
+
+	for key, sym := range doc.scope.index {
+		for _, o := range sym.occurrences {
+			if o.definition {
+				addPath(w.definitions, key, filepath)
+			} else {
+				addPath(w.references, key, filepath)
+			}
+		}
+	}
+
+	for _, call := range doc.scope.calls {
+		addPath(w.references, symbolKey{symbolFunction, call.name.Lit()}, filepath)
+	}
+
+	return nil
+}
+
+// `Remove` removes the document at filepath from w, along with its symbols.
+func (w *Workspace) Remove(filepath string) {
+	filepath = cleanPath(filepath)
+
+	if _, ok := w.documents[filepath]; ok {
+		w.remove(filepath)
+		w.link()
+	}
+}
+
+// `remove` removes the document at filepath and its symbols from the indexes of w, without linking the
+// other documents again.
+func (w *Workspace) remove(filepath string) {
+	doc, ok := w.documents[filepath]
+	if !ok {
+		return
+	}
+
+	if doc.scope != nil {
+		for key := range doc.scope.index {
+			removePath(w.definitions, key, filepath)
+			removePath(w.references, key, filepath)
+		}
+		for _, call := range doc.scope.calls {
+			removePath(w.references, symbolKey{symbolFunction, call.name.Lit()}, filepath)
+		}
+	}
+
+	delete(w.documents, filepath)
+}
+
+// `addPath` adds path to the paths indexed by key.
+func addPath(index map[symbolKey]map[string]bool, key symbolKey, path string) {
+	if index[key] == nil {
+		index[key] = map[string]bool{}
+	}
+	index[key][path] = true
+}
+
+// `removePath` removes path from the paths indexed by key.
+func removePath(index map[symbolKey]map[string]bool, key symbolKey, path string) {
+	delete(index[key], path)
+	if len(index[key]) == 0 {
+		delete(index, key)
+	}
+}
+
+// `link` numbers the components of the parsed documents of w linked by their includes, directly or
+// not and in either direction, which share their global symbols. It is run whenever a document is
+// updated or removed, so that the queries only look the components up.
+//
+// The paths of includes depend on the script being run, as with Includes, and any document may be
+// that script, so the includes of the documents reached from each of them are resolved in turn.
+func (w *Workspace) link() {
+	linked := map[string][]string{}
+
+	for root, doc := range w.documents {
+		reached := map[string]bool{root: true}
+
+		for queue := []*document{doc}; len(queue) > 0; queue = queue[1:] {
+			current := queue[0]
+
+			for _, target := range current.includes {
+				include := target.resolve(current.path, root)
+				other, ok := w.documents[include]
+				if !ok || other.err != nil {
+					continue
+				}
+
+				linked[current.path] = append(linked[current.path], include)
+				linked[include] = append(linked[include], current.path)
+
+				if !reached[include] {
+					reached[include] = true
+					queue = append(queue, other)
+				}
+			}
+		}
+	}
+
+	w.components = map[string]int{}
+	component := 0
+
+	for path, doc := range w.documents {
+		if _, ok := w.components[path]; ok || doc.err != nil {
+			continue
+		}
+
+		component++
+		w.components[path] = component
+
+		for queue := []string{path}; len(queue) > 0; queue = queue[1:] {
+			for _, other := range linked[queue[0]] {
+				if _, ok := w.components[other]; !ok {
+					w.components[other] = component
+					queue = append(queue, other)
+				}
+			}
+		}
+	}
+}
+
+// `SymbolAt` returns the variable or function whose name is at offset, a byte offset into the document
+// at filepath, with its definitions and references in every document sharing it, or nil if there is
+// none. A command name is a function when a document defines one with its name, as with SymbolAt. An
+// error is returned if there is no document at filepath or if it could not be parsed.
+func (w *Workspace) SymbolAt(filepath string, offset uint) (*WorkspaceSymbol, error) {
+	filepath = cleanPath(filepath)
+
+	doc, ok := w.documents[filepath]
+
+	if !ok {
+		return nil, fmt.Errorf("no document at %s", filepath)
+	}
+
+	if doc.err != nil {
+		return nil, doc.err
+	}
+
+	if sym := doc.scope.at(offset, doc.original); sym != nil {
+		if sym.scope != doc.scope {
+			return doc.symbol(sym), nil
+		}
+		return w.global(doc, sym.symbolKey), nil
+	}
+
+	for _, call := range doc.scope.calls {
+		pos, end := doc.original.pos(mapPos(call.name.Pos())), doc.original.pos(mapPos(call.name.End()))
+		if pos.Offset <= offset && offset <= end.Offset {
+			return w.global(doc, symbolKey{symbolFunction, call.name.Lit()}), nil
+		}
+	}
+
+	return nil, nil
+}
+
+// `UnusedFunctions` returns the global functions of the documents of w which are never called, nor
+// removed with `unset -f`, in any document sharing them, ordered by their first definition.
+func (w *Workspace) UnusedFunctions() []WorkspaceSymbol {
+	unused := []WorkspaceSymbol{}
+
+	for key, paths := range w.definitions {
+		if key.kind != symbolFunction {
+			continue
+		}
+
+		seen := map[int]bool{}
+
+		for path := range paths {
+			if seen[w.components[path]] {
+				continue
+			}
+			seen[w.components[path]] = true
+
+			if function := w.global(w.documents[path], key); len(function.References) == 0 {
+				unused = append(unused, *function)
+			}
+		}
+	}
+
+	sort.Slice(unused, func(i, j int) bool {
+		a, b := unused[i].Definitions[0], unused[j].Definitions[0]
+		return a.Path < b.Path || a.Path == b.Path && a.Pos.Offset < b.Pos.Offset
+	})
+
+	return unused
+}
+
+// `global` returns the global variable or function key of doc, with its definitions and references in
+// every document sharing it, as found through the definitions and references of w.
+func (w *Workspace) global(doc *document, key symbolKey) *WorkspaceSymbol {
+	result := &WorkspaceSymbol{Name: key.name, Kind: key.kind, Definitions: []Location{}, References: []Location{}}
+
+	for _, path := range w.shared(doc.path, key) {
+		other := w.documents[path]
+
+		if sym, ok := other.scope.index[key]; ok {
+			other.occurrences(result, sym)
+		}
+
+		if key.kind != symbolFunction {
+			continue
+		}
+
+		for _, call := range other.scope.calls {
+			if call.name.Lit() == key.name {
+				result.References = append(result.References, other.location(call.kind, call.name.Pos(), call.name.End()))
+			}
+		}
+	}
+
+	return result
+}
+
+// `shared` returns the paths of the documents sharing the global symbols of the one at path which define
+// or use key, in order.
+func (w *Workspace) shared(path string, key symbolKey) []string {
+	component := w.components[path]
+	found := map[string]bool{}
+
+	for _, index := range []map[symbolKey]map[string]bool{w.definitions, w.references} {
+		for other := range index[key] {
+			if w.components[other] == component {
+				found[other] = true
+			}
+		}
+	}
+
+	paths := make([]string, 0, len(found))
+	for other := range found {
+		paths = append(paths, other)
+	}
+
+	sort.Strings(paths)
+
+	return paths
+}
+
+// `symbol` returns the local variable or function sym of the document, with its occurrences.
+func (doc *document) symbol(sym *symbol) *WorkspaceSymbol {
+	result := &WorkspaceSymbol{Name: sym.name, Kind: sym.kind, Definitions: []Location{}, References: []Location{}}
+	doc.occurrences(result, sym)
+	return result
+}
+
+// `occurrences` adds the occurrences of sym in the document to the definitions and references of result.
+func (doc *document) occurrences(result *WorkspaceSymbol, sym *symbol) {
+	for _, o := range sym.occurrences {
+		location := doc.location(o.kind, o.pos, o.end)
+		if o.definition {
+			result.Definitions = append(result.Definitions, location)
+		} else {
+			result.References = append(result.References, location)
+		}
+	}
+}
+
+// `location` returns the range between pos and end of the document, as an occurrence of kind.
+func (doc *document) location(kind string, pos, end syntax.Pos) Location {
+	return Location{
+		Path: doc.path,
+		Kind: kind,
+		Pos:  doc.original.pos(mapPos(pos)),
+		End:  doc.original.pos(mapPos(end)),
+	}
+}
@@ -0,0 +1,185 @@
+package processor
+
+import (
+	"sort"
+	"strings"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// tabWidth is the number of columns a tab advances to when measuring lines
+// against PrintWidth.
+const tabWidth = 8
+
+type lineBreak struct {
+	offset   uint
+	priority int
+}
+
+// `wrapLines` breaks the lines of the printed text that are wider than PrintWidth.
+//
+// syntax.Printer decides where to break a command from the line numbers of its nodes, so rather than
+// rewriting positions we insert a backslash-newline in front of an operator or argument of each
+// over-long line, then parse and print the result again so the printer applies its own indentation
+// and BinaryNextLine layout. This repeats until no over-long line can be broken any further.
+func wrapLines(text string, filepath string, syntaxOptions SyntaxOptions) (string, error) {
+	if syntaxOptions.Minify || syntaxOptions.SingleLine {
+		return text, nil
+	}
+
+	for {
+		file, err := Parse(text, filepath, syntaxOptions.ParserOptions)
+
+		if err != nil {
+			return "", err
+		}
+
+		offsets := findLineBreaks(text, file, syntaxOptions.PrintWidth)
+
+		if len(offsets) == 0 {
+			return text, nil
+		}
+
+		var sb strings.Builder
+		last := uint(0)
+		for _, offset := range offsets {
+			sb.WriteString(text[last:offset])
+			sb.WriteString("\\\n")
+			last = offset
+		}
+		sb.WriteString(text[last:])
+
+		file, err = Parse(sb.String(), filepath, syntaxOptions.ParserOptions)
+
+		if err != nil {
+			return "", err
+		}
+
+		wrapped, err := printFile(file, syntaxOptions.PrinterOptions)
+
+		if err != nil {
+			return "", err
+		}
+
+		if wrapped == text {
+			return text, nil
+		}
+
+		text = wrapped
+	}
+}
+
+// `findLineBreaks` returns, in ascending order, at most one offset per line of text wider than width
+// in front of which a line continuation should be inserted.
+//
+// `&&`, `||` and pipe operators are preferred over command arguments, and outer nodes over nested ones.
+// An argument is only chosen when it is the first one to end past width, in which case a preceding
+// `-flag` is moved along with it. Heredoc bodies and the lines starting them are left alone, and
+// nothing is broken in front of a node which already starts its line.
+func findLineBreaks(text string, file *syntax.File, width uint) []uint {
+	lineStarts := []uint{0}
+	for i := 0; i < len(text); i++ {
+		if text[i] == '\n' {
+			lineStarts = append(lineStarts, uint(i+1))
+		}
+	}
+
+	column := func(pos syntax.Pos) uint {
+		return displayWidth(text[lineStarts[pos.Line()-1]:pos.Offset()])
+	}
+
+	overlong := func(line uint) bool {
+		start := lineStarts[line-1]
+		end := uint(len(text))
+		if int(line) < len(lineStarts) {
+			end = lineStarts[line] - 1
+		}
+		return displayWidth(text[start:end]) > width
+	}
+
+	breakable := func(pos syntax.Pos) bool {
+		if !pos.IsValid() || pos.IsRecovered() || !overlong(pos.Line()) {
+			return false
+		}
+		return strings.TrimLeft(text[lineStarts[pos.Line()-1]:pos.Offset()], " \t") != ""
+	}
+
+	var heredocs [][2]uint
+	heredocLines := map[uint]bool{}
+	breaks := map[uint]lineBreak{}
+
+	add := func(pos syntax.Pos, priority int) {
+		if prev, ok := breaks[pos.Line()]; ok && prev.priority <= priority {
+			return
+		}
+		breaks[pos.Line()] = lineBreak{offset: pos.Offset(), priority: priority}
+	}
+
+	syntax.Walk(file, func(node syntax.Node) bool {
+		switch node := node.(type) {
+		case *syntax.Redirect:
+			if node.Hdoc != nil {
+				heredocs = append(heredocs, [2]uint{node.Hdoc.Pos().Offset(), node.Hdoc.End().Offset()})
+				heredocLines[node.OpPos.Line()] = true
+			}
+		case *syntax.BinaryCmd:
+			switch node.Op {
+			case syntax.AndStmt, syntax.OrStmt, syntax.Pipe, syntax.PipeAll:
+				if node.Y.Pos().Line() == node.OpPos.Line() && breakable(node.OpPos) {
+					add(node.OpPos, 0)
+				}
+			}
+		case *syntax.CallExpr:
+			for i, arg := range node.Args {
+				if i == 0 {
+					continue
+				}
+				end := arg.End()
+				if end.Line() == arg.Pos().Line() && column(end) <= width {
+					continue
+				}
+				prev := node.Args[i-1]
+				if i > 1 && prev.Pos().Line() == arg.Pos().Line() &&
+					strings.HasPrefix(prev.Lit(), "-") && !strings.HasPrefix(arg.Lit(), "-") {
+					arg = prev
+				}
+				if breakable(arg.Pos()) {
+					add(arg.Pos(), 1)
+				}
+				break
+			}
+		}
+		return true
+	})
+
+	var offsets []uint
+	for line, lb := range breaks {
+		inHeredoc := heredocLines[line]
+		for _, heredoc := range heredocs {
+			if lb.offset >= heredoc[0] && lb.offset < heredoc[1] {
+				inHeredoc = true
+				break
+			}
+		}
+		if !inHeredoc {
+			offsets = append(offsets, lb.offset)
+		}
+	}
+
+	sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+
+	return offsets
+}
+
+// `displayWidth` returns the number of columns s occupies, expanding tabs to tabWidth.
+func displayWidth(s string) uint {
+	var width uint
+	for _, r := range s {
+		if r == '\t' {
+			width += tabWidth - width%tabWidth
+		} else {
+			width++
+		}
+	}
+	return width
+}
@@ -2,3 +2,4 @@ import '../vendors/wasm_exec.cjs'
 
 export * from './processor.js'
 export * from './types.js'
+export * from './workspace.js'
@@ -6,6 +6,7 @@ import './shim.js'
 import '../vendors/wasm_exec.cjs'
 
 import { getProcessor } from './processor.js'
+import { getWorkspace } from './workspace.js'
 import type { File, ShOptions, ShPrintOptions } from './types.js'
 
 const importMetaUrl = import.meta.url
@@ -19,9 +20,19 @@ export const processor = getProcessor(() =>
   fs.readFile(path.resolve(_dirname, '../main.wasm')),
 )
 
+export const workspace = getWorkspace(() =>
+  fs.readFile(path.resolve(_dirname, '../main.wasm')),
+)
+
 export const parse = (text: string, options?: ShOptions) =>
   processor(text, options)
 
+export const lint = (text: string, options?: ShOptions) =>
+  processor(text, { ...options, lint: true })
+
+export const symbols = (text: string, options?: ShOptions) =>
+  processor(text, { ...options, symbols: true })
+
 export function print(text: string, options?: ShOptions): Promise<string>
 export function print(ast: File, options?: ShPrintOptions): Promise<string>
 export function print(
@@ -41,3 +52,4 @@ export function print(
 
 export * from './processor.js'
 export * from './types.js'
+export * from './workspace.js'
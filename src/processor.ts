@@ -1,8 +1,19 @@
 import {
   type IParseError,
+  type CommandInventory,
+  type Diagnostic,
+  type EnvironmentVariable,
+  type IncludeGraph,
   type File,
+  type PrintResult,
+  type RenameResult,
+  type Scope,
+  type ScopeSymbol,
+  type Report,
   type ShOptions,
   LangVariant,
+  LineEnding,
+  FunctionStyle,
 } from './types.js'
 
 export class ParseError extends Error implements IParseError {
@@ -43,7 +54,39 @@ export const getProcessor = (
   encoder ??= new TextEncoder()
   decoder ??= new TextDecoder()
 
+  function processor(
+    text: string,
+    options?: ShOptions & { lint: true },
+  ): Promise<Diagnostic[]>
+  function processor(
+    text: string,
+    options?: ShOptions & { commands: true },
+  ): Promise<CommandInventory>
+  function processor(
+    text: string,
+    options?: ShOptions & { environment: true },
+  ): Promise<EnvironmentVariable[]>
+  function processor(
+    text: string,
+    options?: ShOptions & { includes: true },
+  ): Promise<IncludeGraph>
+  function processor(
+    text: string,
+    options?: ShOptions & { offset: number; rename: string },
+  ): Promise<RenameResult>
+  function processor(
+    text: string,
+    options?: ShOptions & { symbols: true; offset: number },
+  ): Promise<ScopeSymbol | null>
+  function processor(
+    text: string,
+    options?: ShOptions & { symbols: true },
+  ): Promise<Scope>
   function processor(text: string, options?: ShOptions): Promise<File>
+  function processor(
+    text: string,
+    options?: ShOptions & { print: true; report: true },
+  ): Promise<PrintResult>
   function processor(
     text: string,
     options?: ShOptions & { print: true },
@@ -64,7 +107,11 @@ export const getProcessor = (
    * instantiated, allocates memory for the file path and text content, and then
    * calls the module's processing function with the provided options. Depending
    * on the `print` flag, it returns either the processed text or a File
-   * representing the parsed AST.
+   * representing the parsed AST. With both `print` and `report`, the text is
+   * returned together with the report of rewrites applied while printing, and
+   * with `lint` instead of `print`, the diagnostics of the lint rules are
+   * returned, and with `symbols`, the scope tree of its variables and
+   * functions.
    *
    * @param textOrAst - The shell script input as a string or as an AST File.
    *   When providing a non-string input and `print` is false, the
@@ -75,6 +122,31 @@ export const getProcessor = (
    *       error reporting.
    *   - `print`: If true, the function returns the processed text; otherwise, it
    *       returns the processed AST as a File.
+   *   - `report`: If true along with `print`, the function returns a
+   *       {@link PrintResult} instead of the bare text.
+   *   - `lint`: If true and `print` is not, the function returns the
+   *       {@link Diagnostic}s reported by the lint rules.
+   *   - `symbols`: If true and neither `print` nor `lint` is, the function
+   *       returns the {@link Scope} tree of the variables and functions defined
+   *       and referenced in the script.
+   *   - `offset`: A byte offset into the text which, along with `symbols`,
+   *       makes the function return the {@link ScopeSymbol} whose name is at
+   *       that offset, with all of its definitions and references, or null.
+   *   - `rename`: A new name for the variable or function at `offset`, which
+   *       makes the function return the edits renaming it as a
+   *       {@link RenameResult}, unless the name is invalid or already used.
+   *   - `commands`: If true, the function returns the {@link CommandInventory}
+   *       of the builtins, functions and external programs the script runs,
+   *       including those run by `command`, `exec`, `sudo`, `env` and `xargs`.
+   *   - `environment`: If true, the function returns the
+   *       {@link EnvironmentVariable}s the script reads without assigning them,
+   *       with their read sites and whether they have a default value.
+   *   - `includes`: If true, the function returns the {@link IncludeGraph} of
+   *       the files the script includes with `source` or `.`, recursively.
+   *   - `files`: The content of the files which may be included, by path. The
+   *       paths included are resolved relative to the directory of `filepath`,
+   *       except for those based on `$BASH_SOURCE`, which are resolved relative
+   *       to the file including them.
    *   - `originalText`: The original text of the shell script, required when
    *       `textOrAst` is not a string.
    *   - `keepComments`: Determines whether comments should be preserved in the
@@ -88,9 +160,45 @@ export const getProcessor = (
    *   - `binaryNextLine`, `switchCaseIndent`, `spaceRedirects`, `keepPadding`,
    *       `minify`, `singleLine`, `functionNextLine`: Additional flags that
    *       influence formatting details and output structure.
+   *   - `printWidth`: The line width to wrap long commands at, 0 disables
+   *       wrapping.
+   *   - `singleQuote`, `braceParams`, `plainAnsiCQuotes`: Quote style rewrites
+   *       applied before printing.
+   *   - `lineEnding`: The line breaks of the printed script (default is
+   *       {@link LineEnding.LineEndingAuto}).
+   *   - `maxBlankLines`, `alignComments`, `alignAssignments`: Layout controls
+   *       applied after printing. A `maxBlankLines` of 0, the default, or 1
+   *       keeps the single blank line of the printer.
+   *   - `functionStyle`: The form function declarations are printed in
+   *       (default is {@link FunctionStyle.FunctionStyleKeep}).
+   *   - `doubleBrackets`: Whether to rewrite `[` and `test` commands into
+   *       `[[ ]]` test clauses.
+   *   - `preset`: The name of a style guide whose options replace the default
+   *       ones of `indent`, `binaryNextLine`, `switchCaseIndent`,
+   *       `spaceRedirects` and `functionNextLine`, unless they are set.
+   *   - `editorConfigs`: The `.editorconfig` files whose sections matching
+   *       `filepath` replace the default options, unless they are set.
+   *   - `simplify`: Whether to apply the simplify rewrite pass before printing.
+   *   - `sourceMap`: Whether to map the original positions to the printed ones.
+   *   - `verify`: Whether to check that the printed script is equivalent to the
+   *       original one.
+   *   - `fixedPoint`: Whether to print the output again until it no longer
+   *       changes.
+   *   - `tolerant`: Whether to keep the regions which cannot be parsed verbatim
+   *       instead of failing.
+   *   - `reportBackquotes`: Whether to report the backquoted command
+   *       substitutions printed as `$(...)` as fixes.
+   *   - `checkComments`: Whether to report the comments dropped or moved by
+   *       the printer as warnings.
+   *   - `rules`: The lint rules to disable, or to enable with another severity.
    *
    * @returns A promise that resolves to either the processed text (if `print`
-   *   is true) or a File (if `print` is false).
+   *   is true), a PrintResult (if `report` is true as well), the diagnostics
+   *   (if `lint` is true), the edits of `rename`, the scope tree (if `symbols`
+   *   is true), the symbol at `offset` (if it is given as well), the command
+   *   inventory (if `commands` is true), the environment variables (if
+   *   `environment` is true), the include graph (if `includes` is true) or a
+   *   File (if none is).
    * @throws {TypeError} If the original text is required but not provided.
    * @throws {ParseError} If the processed output is not valid JSON or indicates
    *   a parsing error.
@@ -102,25 +210,68 @@ export const getProcessor = (
     {
       filepath,
       print = false,
+      report = false,
+      lint = false,
+      symbols = false,
+      offset,
+      rename = '',
+      commands = false,
+      environment = false,
+      includes = false,
+      files = {},
       originalText,
 
       keepComments = true,
-      variant = LangVariant.LangBash,
+      variant,
       stopAt = '',
       recoverErrors = 0,
 
-      useTabs = false,
-      tabWidth = 2,
-      indent = useTabs ? 0 : tabWidth,
-      binaryNextLine = true,
-      switchCaseIndent = true,
-      spaceRedirects = true,
+      useTabs,
+      tabWidth,
+      indent,
+      binaryNextLine,
+      switchCaseIndent,
+      spaceRedirects,
       // eslint-disable-next-line sonarjs/deprecation
       keepPadding = false,
       minify = false,
       singleLine = false,
-      functionNextLine = false,
-    }: ShOptions & { print?: boolean; originalText?: string } = {},
+      functionNextLine,
+      printWidth = 0,
+      singleQuote = false,
+      braceParams = false,
+      plainAnsiCQuotes = false,
+      lineEnding,
+      maxBlankLines = 0,
+      alignComments = false,
+      alignAssignments = false,
+      functionStyle = FunctionStyle.FunctionStyleKeep,
+      doubleBrackets = false,
+      preset = '',
+      editorConfigs = [],
+
+      simplify = false,
+      sourceMap = false,
+      verify = false,
+      fixedPoint = false,
+      tolerant = false,
+      reportBackquotes = false,
+      checkComments = false,
+
+      rules = {},
+    }: ShOptions & {
+      print?: boolean
+      report?: boolean
+      lint?: boolean
+      symbols?: boolean
+      offset?: number
+      rename?: string
+      commands?: boolean
+      environment?: boolean
+      includes?: boolean
+      files?: Record<string, string>
+      originalText?: string
+    } = {},
   ) {
     if (!wasmBufferSource && !wasmBufferSourcePromise && getWasm.length === 0) {
       wasmBufferSourcePromise = Promise.resolve(
@@ -145,6 +296,19 @@ export const getProcessor = (
       }
     }
 
+    const overrides = Object.entries({
+      variant,
+      indent: indent ?? useTabs ?? tabWidth,
+      binaryNextLine,
+      switchCaseIndent,
+      spaceRedirects,
+      functionNextLine,
+      lineEnding,
+    })
+      .filter(([, value]) => value != null)
+      .map(([key]) => key)
+      .join(',')
+
     const go = new Go()
 
     const wasm =
@@ -180,6 +344,15 @@ export const getProcessor = (
         text1: number,
 
         isAst: boolean,
+        lint: boolean,
+        symbols: boolean,
+        offset: number,
+        renamePointer: number,
+        rename0: number,
+        rename1: number,
+        commands: boolean,
+        environment: boolean,
+        includes: boolean,
 
         keepComments: boolean,
         variant: LangVariant,
@@ -196,12 +369,57 @@ export const getProcessor = (
         minify: boolean,
         singleLine: boolean,
         functionNextLine: boolean,
+        printWidth: number,
+        singleQuote: boolean,
+        braceParams: boolean,
+        plainAnsiCQuotes: boolean,
+        lineEnding: LineEnding,
+        maxBlankLines: number,
+        alignComments: boolean,
+        alignAssignments: boolean,
+        functionStyle: FunctionStyle,
+        doubleBrackets: boolean,
+        presetPointer: number,
+        preset0: number,
+        preset1: number,
+        editorConfigsPointer: number,
+        editorConfigs0: number,
+        editorConfigs1: number,
+        overridesPointer: number,
+        overrides0: number,
+        overrides1: number,
+
+        simplify: boolean,
+        sourceMap: boolean,
+        verify: boolean,
+        fixedPoint: boolean,
+        tolerant: boolean,
+        reportBackquotes: boolean,
+        checkComments: boolean,
+
+        rulesPointer: number,
+        rules0: number,
+        rules1: number,
+
+        filesPointer: number,
+        files0: number,
+        files1: number,
       ) => number
     }
 
     const filePath = encoder!.encode(filepath)
     const text = encoder!.encode(originalText || (textOrAst as string))
+    const uRename = encoder!.encode(rename)
     const uStopAt = encoder!.encode(stopAt)
+    const uPreset = encoder!.encode(preset)
+    const uEditorConfigs = encoder!.encode(JSON.stringify(editorConfigs))
+    const uOverrides = encoder!.encode(overrides)
+    const uFiles = encoder!.encode(JSON.stringify(files))
+    const uRules = encoder!.encode(
+      Object.entries(rules)
+        .map(([id, value]) => `${id}=${value === true ? 'on' : value || 'off'}`)
+        .join(','),
+    )
 
     const filePathPointer = wasmAlloc(filePath.byteLength)
     new Uint8Array(memory.buffer).set(filePath, filePathPointer)
@@ -209,9 +427,27 @@ export const getProcessor = (
     const textPointer = wasmAlloc(text.byteLength)
     new Uint8Array(memory.buffer).set(text, textPointer)
 
+    const renamePointer = wasmAlloc(uRename.byteLength)
+    new Uint8Array(memory.buffer).set(uRename, renamePointer)
+
     const stopAtPointer = wasmAlloc(uStopAt.byteLength)
     new Uint8Array(memory.buffer).set(uStopAt, stopAtPointer)
 
+    const presetPointer = wasmAlloc(uPreset.byteLength)
+    new Uint8Array(memory.buffer).set(uPreset, presetPointer)
+
+    const editorConfigsPointer = wasmAlloc(uEditorConfigs.byteLength)
+    new Uint8Array(memory.buffer).set(uEditorConfigs, editorConfigsPointer)
+
+    const overridesPointer = wasmAlloc(uOverrides.byteLength)
+    new Uint8Array(memory.buffer).set(uOverrides, overridesPointer)
+
+    const rulesPointer = wasmAlloc(uRules.byteLength)
+    new Uint8Array(memory.buffer).set(uRules, rulesPointer)
+
+    const filesPointer = wasmAlloc(uFiles.byteLength)
+    new Uint8Array(memory.buffer).set(uFiles, filesPointer)
+
     const resultPointer = process(
       filePathPointer,
       filePath.byteLength,
@@ -222,27 +458,77 @@ export const getProcessor = (
       text.byteLength,
 
       print,
+      lint,
+      symbols,
+      offset ?? -1,
+      renamePointer,
+      uRename.byteLength,
+      uRename.byteLength,
+      commands,
+      environment,
+      includes,
 
       keepComments,
-      variant,
+      variant ?? LangVariant.LangBash,
       stopAtPointer,
       uStopAt.byteLength,
       uStopAt.byteLength,
       recoverErrors,
 
-      indent,
-      binaryNextLine,
-      switchCaseIndent,
-      spaceRedirects,
+      indent ?? (useTabs ? 0 : (tabWidth ?? 2)),
+      binaryNextLine ?? true,
+      switchCaseIndent ?? true,
+      spaceRedirects ?? true,
       keepPadding,
       minify,
       singleLine,
-      functionNextLine,
+      functionNextLine ?? false,
+      printWidth,
+      singleQuote,
+      braceParams,
+      plainAnsiCQuotes,
+      lineEnding ?? LineEnding.LineEndingAuto,
+      maxBlankLines,
+      alignComments,
+      alignAssignments,
+      functionStyle,
+      doubleBrackets,
+      presetPointer,
+      uPreset.byteLength,
+      uPreset.byteLength,
+      editorConfigsPointer,
+      uEditorConfigs.byteLength,
+      uEditorConfigs.byteLength,
+      overridesPointer,
+      uOverrides.byteLength,
+      uOverrides.byteLength,
+
+      simplify,
+      sourceMap,
+      verify,
+      fixedPoint,
+      tolerant,
+      reportBackquotes,
+      checkComments,
+
+      rulesPointer,
+      uRules.byteLength,
+      uRules.byteLength,
+
+      filesPointer,
+      uFiles.byteLength,
+      uFiles.byteLength,
     )
 
     wasmFree(filePathPointer)
     wasmFree(textPointer)
+    wasmFree(renamePointer)
     wasmFree(stopAtPointer)
+    wasmFree(presetPointer)
+    wasmFree(editorConfigsPointer)
+    wasmFree(overridesPointer)
+    wasmFree(rulesPointer)
+    wasmFree(filesPointer)
 
     const result = new Uint8Array(memory.buffer).subarray(resultPointer)
     const end = result.indexOf(0)
@@ -264,11 +550,24 @@ export const getProcessor = (
       text: processedText,
       parseError,
       message,
-    } = JSON.parse(string) as {
+      diagnostics,
+      symbols: scope,
+      symbol,
+      commands: inventory,
+      environment: variables,
+      includes: graph,
+      ...printReport
+    } = JSON.parse(string) as Report & {
       file: File
       text: string
       parseError: IParseError | null
       message: string
+      diagnostics: Diagnostic[] | null
+      symbols: Scope | null
+      symbol: ScopeSymbol | null
+      commands: CommandInventory | null
+      environment: EnvironmentVariable[] | null
+      includes: IncludeGraph | null
     }
 
     if (parseError || message) {
@@ -278,7 +577,38 @@ export const getProcessor = (
         : new ParseError(parseError)
     }
 
-    return print ? processedText : file
+    if (lint && !print) {
+      return diagnostics ?? []
+    }
+
+    if (rename && offset != null && !print) {
+      return {
+        fixes: printReport.fixes ?? [],
+        warnings: printReport.warnings ?? [],
+      }
+    }
+
+    if (symbols && !print) {
+      return offset == null ? scope : symbol
+    }
+
+    if (commands && !print) {
+      return inventory
+    }
+
+    if (environment && !print) {
+      return variables ?? []
+    }
+
+    if (includes && !print) {
+      return graph
+    }
+
+    if (!print) {
+      return file
+    }
+
+    return report ? { ...printReport, text: processedText } : processedText
   }
 
   return processor
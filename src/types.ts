@@ -66,6 +66,42 @@ export const LangVariant = {
 
 export type LangVariant = ValueOf<typeof LangVariant>
 
+/* eslint-disable @typescript-eslint/no-magic-numbers -- `LineEnding` mirrors
+ * the `iota` constants of `processor.LineEnding`.
+ */
+export const LineEnding = {
+  /**
+   * LineEndingAuto keeps the style of the first line break of the original
+   * text, or LF if it has none.
+   */
+  LineEndingAuto: 0,
+  LineEndingLF: 1,
+  LineEndingCRLF: 2,
+} as const
+/* eslint-enable @typescript-eslint/no-magic-numbers */
+
+export type LineEnding = ValueOf<typeof LineEnding>
+
+/* eslint-disable @typescript-eslint/no-magic-numbers -- `FunctionStyle`
+ * mirrors the `iota` constants of `processor.FunctionStyle`.
+ */
+export const FunctionStyle = {
+  /** FunctionStyleKeep prints function declarations as they were written. */
+  FunctionStyleKeep: 0,
+  /** FunctionStylePOSIX prints function declarations as `foo() {`. */
+  FunctionStylePOSIX: 1,
+  /** FunctionStyleKeyword prints function declarations as `function foo {`. */
+  FunctionStyleKeyword: 2,
+  /**
+   * FunctionStyleKeywordParens prints function declarations as `function
+   * foo() {`.
+   */
+  FunctionStyleKeywordParens: 3,
+} as const
+/* eslint-enable @typescript-eslint/no-magic-numbers */
+
+export type FunctionStyle = ValueOf<typeof FunctionStyle>
+
 export interface ShParserOptions {
   /**
    * KeepComments makes the parser parse comments and attach them to nodes, as
@@ -170,12 +206,169 @@ export interface ShPrinterOptions {
   singleLine?: boolean
   /** FunctionNextLine will place a function's opening braces on the next line. */
   functionNextLine?: boolean
+  /**
+   * PrintWidth is the maximum line width to aim for. Longer argument lists,
+   * pipelines and `&&`/`||` chains are broken across lines, honouring
+   * {@link ShPrinterOptions.binaryNextLine}; tabs count as 8 columns. Lines
+   * that cannot be broken, such as heredoc bodies or comments, are left as
+   * they are. 0, the default, disables wrapping.
+   */
+  printWidth?: number
+  /**
+   * SingleQuote will rewrite double-quoted strings without any expansion, such
+   * as `"foo bar"`, into single-quoted ones. Strings containing a single quote,
+   * nested in another double-quoted string or in an arithmetic expression are
+   * left untouched.
+   */
+  singleQuote?: boolean
+  /**
+   * BraceParams will add braces to short parameter expansions, e.g. `$foo` to
+   * `${foo}`.
+   */
+  braceParams?: boolean
+  /**
+   * PlainAnsiCQuotes will rewrite `$'...'` strings without any escape sequence
+   * into plain single-quoted strings.
+   */
+  plainAnsiCQuotes?: boolean
+  /**
+   * LineEnding sets the line breaks of the printed script, including the ones
+   * within heredocs and quoted strings. Defaults to {@link
+   * LineEnding.LineEndingAuto} when omitted. A byte-order mark at the start of
+   * the original text is always kept.
+   */
+  lineEnding?: LineEnding
+  /**
+   * MaxBlankLines keeps up to this many consecutive blank lines of the original
+   * text before each statement or comment, where the printer keeps at most one.
+   * Values below 2, including the default 0, keep the behaviour of the printer:
+   * blank lines cannot be removed altogether.
+   */
+  maxBlankLines?: number
+  /**
+   * AlignComments aligns the trailing comments of consecutive lines into one
+   * column, at any nesting level and after the other layout options are
+   * applied.
+   */
+  alignComments?: boolean
+  /**
+   * AlignAssignments aligns the `=` of lone declarations such as `local a=1` on
+   * consecutive lines with the same indentation and keyword, by adding blanks
+   * between the keyword and the variable names. Plain assignments such as `a=1`
+   * are left alone, as a shell does not accept blanks around `=`.
+   */
+  alignAssignments?: boolean
+  /**
+   * FunctionStyle rewrites every function declaration into the given form, each
+   * one being reported in {@link Report.fixes}. The styles using the `function`
+   * keyword are refused with {@link LangVariant.LangPOSIX}, and declarations
+   * with a subshell body keep their parentheses. Defaults to {@link
+   * FunctionStyle.FunctionStyleKeep} when omitted.
+   */
+  functionStyle?: FunctionStyle
+  /**
+   * DoubleBrackets rewrites `[` and `test` commands into `[[ ]]` test clauses
+   * for the variants which have them, each one being reported in {@link
+   * Report.fixes}. Commands whose behaviour could change are left alone, such
+   * as those with unquoted expansions relying on word splitting, unquoted globs
+   * or arithmetic comparisons of arbitrary values.
+   */
+  doubleBrackets?: boolean
+  /**
+   * Preset sets `indent`, `binaryNextLine`, `switchCaseIndent`,
+   * `spaceRedirects` and `functionNextLine` to the ones of a style guide,
+   * unless they are given as well: `google` for the Google Shell Style Guide,
+   * `shfmt-default` for the defaults of shfmt and `compact` for a two-space
+   * indentation without any of the optional spacing. The resolved options are
+   * reported in {@link Report.printerOptions}.
+   */
+  preset?: 'compact' | 'google' | 'shfmt-default'
 }
 
-export interface ShSyntaxOptions extends ShParserOptions, ShPrinterOptions {}
+export interface EditorConfig {
+  /**
+   * The directory containing the file, which `filepath` must be within for the
+   * file to apply. The file applies to any `filepath` when omitted.
+   */
+  dir?: string
+  /** The text of the `.editorconfig` file. */
+  text: string
+}
 
-export interface ShOptions extends ShSyntaxOptions {
+export interface ShSyntaxOptions extends ShParserOptions, ShPrinterOptions {
+  /**
+   * Simplify applies the simplify rewrite pass of `mvdan/sh` before printing,
+   * e.g. removing redundant quotes in `[[ "$x" == y ]]` or turning `$((
+   * ($a) ))` into `$((a))`. Each rewritten node is listed in
+   * {@link Report.simplifications}.
+   */
+  simplify?: boolean
+  /**
+   * SourceMap will report, in {@link Report.sourceMap}, the position of every
+   * node of the original text in the printed text.
+   */
+  sourceMap?: boolean
+  /**
+   * Verify will parse the printed script again and compare its syntax tree with
+   * the original one, ignoring positions and comments, failing with the first
+   * differing node if the two scripts are not equivalent. The rewrites of the
+   * quote style options, `minify` and `functionStyle` are only expected when
+   * they are set, while with `simplify` or `doubleBrackets` the output is
+   * compared with the rewritten syntax tree instead.
+   */
+  verify?: boolean
+  /**
+   * FixedPoint will print the printed script again until the output no longer
+   * changes, reporting the number of passes needed in {@link Report.passes}
+   * and, for inputs which are not printed idempotently, the differences
+   * between the first two passes in {@link Report.diff}.
+   */
+  fixedPoint?: boolean
+  /**
+   * Tolerant will format the parts of a script which can be parsed and keep the
+   * other ones verbatim instead of failing, reporting every syntax error in
+   * {@link Report.parseErrors}. A part which can be parsed but not printed is
+   * kept verbatim too, with the error in {@link Report.warnings}.
+   */
+  tolerant?: boolean
+  /**
+   * ReportBackquotes will report, in {@link Report.fixes}, every legacy
+   * backquoted command substitution, which is always printed as `$(...)`.
+   */
+  reportBackquotes?: boolean
+  /**
+   * CheckComments will parse the printed script again to report, in {@link
+   * Report.warnings}, the comments dropped or moved to another node by the
+   * printer.
+   */
+  checkComments?: boolean
+}
+
+export type Severity = 'error' | 'info' | 'style' | 'warning'
+
+export interface ShLintOptions {
+  /**
+   * Rules configures the lint rules by ID, such as `backquote`: `false`
+   * disables a rule, `true` enables it with its default severity, and a
+   * severity enables it with that one instead. Rules which are not configured
+   * are enabled.
+   */
+  rules?: Record<string, Severity | boolean>
+}
+
+export interface ShOptions extends ShSyntaxOptions, ShLintOptions {
   filepath?: string
+  /**
+   * The `.editorconfig` files which may apply to `filepath`, ordered from the
+   * outermost directory to the innermost one. The properties of their sections
+   * matching `filepath`, such as `indent_style`, `indent_size`,
+   * `shell_variant`, `binary_next_line`, `switch_case_indent`,
+   * `space_redirects`, `function_next_line` and `end_of_line`, replace the
+   * default options, but not those which are set, nor those of {@link
+   * ShPrinterOptions.preset}. The resolved options are reported in {@link
+   * Report.parserOptions} and {@link Report.printerOptions}.
+   */
+  editorConfigs?: EditorConfig[]
 
   useTabs?: boolean
   tabWidth?: number
@@ -235,6 +428,279 @@ export interface File extends Node {
   Stmts: Stmt[]
 }
 
+export interface Simplification extends Node {
+  /** The type of the rewritten node, e.g. `Word` or `BinaryTest`. */
+  Node: string
+  /** The source text of the node before it was rewritten. */
+  Text: string
+}
+
+export interface Fix extends Node {
+  Message: string
+  /** The source text between `Pos` and `End` before the fix. */
+  OldText: string
+  /** The text replacing `OldText`. */
+  NewText: string
+}
+
+export interface Warning extends Node {
+  Message: string
+  /** The text the warning is about, such as the text of a lost comment. */
+  Text: string
+}
+
+export interface Diagnostic extends Node {
+  /** The ID of the lint rule which reported the problem. */
+  Rule: string
+  Severity: Severity
+  Message: string
+  /** The rewrite fixing the problem, if the rule has one. */
+  Fix: Fix | null
+}
+
+export interface Occurrence extends Node {
+  /**
+   * How the name is used, such as `assign`, `local`, `for`, `read` or
+   * `function` for a definition, and `expansion`, `arithmetic`, `unset` or
+   * `call` for a reference.
+   */
+  Kind: string
+}
+
+export interface ScopeSymbol {
+  Name: string
+  Kind: 'function' | 'variable'
+  Definitions: Occurrence[]
+  References: Occurrence[]
+}
+
+export interface Scope extends Node {
+  Kind: 'commandSubstitution' | 'file' | 'function' | 'subshell'
+  /** The name of the function, for a function body. */
+  Name: string
+  /**
+   * The variables and functions of the scope. Those declared with `local`,
+   * `declare` or `typeset` belong to the function around them, those assigned
+   * within a subshell or command substitution to it, and the other ones to the
+   * file, including the variables which are only read.
+   */
+  Symbols: ScopeSymbol[]
+  Children: Scope[]
+}
+
+export interface RenameResult {
+  /** The edits renaming every definition and reference of the symbol. */
+  fixes: Fix[]
+  /**
+   * The uses which may refer to the symbol by name but are not renamed, such
+   * as indirect expansions like `${!ref}`.
+   */
+  warnings: Warning[]
+}
+
+export interface Command {
+  Name: string
+  /** The number of times the command is run. */
+  Count: number
+  /** The ranges of the name of the command where it is run. */
+  Calls: Node[]
+}
+
+export interface CommandInventory {
+  Builtins: Command[]
+  /** The commands run which are functions defined in the script. */
+  Functions: Command[]
+  /** The external programs the script depends on. */
+  External: Command[]
+}
+
+export interface EnvironmentRead {
+  /** How the variable is read, such as `expansion` or `arithmetic`. */
+  Kind: string
+  /**
+   * The operator of the expansion giving the variable a default value, such as
+   * `:-` or `:=`, or requiring it to be set, such as `:?`, if any.
+   */
+  Operator: string
+  /** The default value given by the expansion, if any. */
+  Default: string
+  Pos: Pos
+  End: Pos
+}
+
+export interface EnvironmentVariable {
+  Name: string
+  /**
+   * Whether every read of the variable provides a default value, or follows
+   * one assigning it.
+   */
+  Default: boolean
+  /** Whether a read of the variable fails when it is unset, as `${FOO:?}`. */
+  Required: boolean
+  Reads: EnvironmentRead[]
+}
+
+export interface Include {
+  /** The path of the file including another one. */
+  From: string
+  /** The path of the included file, empty if it is not known. */
+  To: string
+  /** The path as written in the script. */
+  Text: string
+  /** Why the included file is not known, if it is not. */
+  Message: string
+  Pos: Pos
+  End: Pos
+}
+
+export interface IncludeFile {
+  Path: string
+  /** The error parsing the file, if any. */
+  Message: string
+  Includes: Include[]
+}
+
+export interface IncludeGraph {
+  /** The files reached from the script, starting with it. */
+  Files: IncludeFile[]
+  Unresolved: Include[]
+  /** The paths of the files of every cycle of files including each other. */
+  Cycles: string[][]
+}
+
+export interface Location extends Occurrence {
+  /** The path of the document the name is in. */
+  Path: string
+}
+
+export interface WorkspaceSymbol {
+  Name: string
+  Kind: 'function' | 'variable'
+  /** The definitions of the symbol in every document sharing it. */
+  Definitions: Location[]
+  /** The references of the symbol in every document sharing it. */
+  References: Location[]
+}
+
+export interface Workspace {
+  /**
+   * Parses the text as the document at `filepath`, adding it to the workspace
+   * or replacing its previous text. Only this document is parsed again.
+   *
+   * @throws {ParseError} If the text cannot be parsed, in which case the
+   *   document is kept without any symbol until it is updated again.
+   */
+  update(filepath: string, text: string, options?: ShParserOptions): void
+  /** Removes the document at `filepath` from the workspace. */
+  remove(filepath: string): void
+  /**
+   * Returns the variable or function whose name is at `offset` in the document
+   * at `filepath`, with its definitions and references in every document
+   * including it or included by it with `source` or `.`, or null if there is
+   * none.
+   */
+  symbolAt(filepath: string, offset: number): WorkspaceSymbol | null
+  /**
+   * Returns the functions which are never called in any document sharing
+   * them.
+   */
+  unusedFunctions(): WorkspaceSymbol[]
+}
+
+export interface Mapping {
+  /** The range of the node in the original text. */
+  Original: Node
+  /** The range of the node in the printed text. */
+  Generated: Node
+}
+
+export interface Report {
+  simplifications: Simplification[] | null
+  /**
+   * Rewrites applied while printing, such as legacy backquoted command
+   * substitutions printed as `$(...)` with {@link
+   * ShSyntaxOptions.reportBackquotes}.
+   */
+  fixes: Fix[] | null
+  sourceMap: Mapping[] | null
+  /**
+   * The number of passes which changed the text with {@link
+   * ShSyntaxOptions.fixedPoint}, 1 if the input is printed idempotently.
+   */
+  passes: number
+  /**
+   * The unified diff between the first and second passes with {@link
+   * ShSyntaxOptions.fixedPoint}, empty if the input is printed idempotently.
+   */
+  diff: string
+  /**
+   * Issues found while printing, such as comments dropped or moved to another
+   * node by the printer with {@link ShSyntaxOptions.checkComments}.
+   */
+  warnings: Warning[] | null
+  /**
+   * The syntax errors of the regions kept verbatim with {@link
+   * ShSyntaxOptions.tolerant}.
+   */
+  parseErrors: IParseError[] | null
+  /** The style of the first line break of the original text. */
+  lineEnding: 'crlf' | 'lf'
+  /** Whether the original text starts with a byte-order mark. */
+  byteOrderMark: boolean
+  /** The {@link ShPrinterOptions.preset} the printer options are based on. */
+  preset: string
+  /**
+   * The options of the modeline of the original text, a comment such as
+   * `# sh-syntax: indent=4 variant=posix minify=false` within its first or
+   * last five lines. They take precedence over any other option, and those
+   * which are unknown or have an invalid value are reported in {@link
+   * Report.warnings}.
+   */
+  modeline: string
+  /**
+   * The parser options resolved from {@link ShOptions.editorConfigs} and
+   * {@link Report.modeline}.
+   */
+  parserOptions: ParserOptions | null
+  /**
+   * The printer options resolved from {@link ShPrinterOptions.preset}, {@link
+   * ShOptions.editorConfigs} and {@link Report.modeline}.
+   */
+  printerOptions: PrinterOptions | null
+}
+
+export interface ParserOptions {
+  KeepComments: boolean
+  Variant: LangVariant
+  StopAt: string
+  RecoverErrors: number
+}
+
+export interface PrinterOptions {
+  Indent: number
+  BinaryNextLine: boolean
+  SwitchCaseIndent: boolean
+  SpaceRedirects: boolean
+  KeepPadding: boolean
+  Minify: boolean
+  SingleLine: boolean
+  FunctionNextLine: boolean
+  PrintWidth: number
+  SingleQuote: boolean
+  BraceParams: boolean
+  PlainAnsiCQuotes: boolean
+  LineEnding: LineEnding
+  MaxBlankLines: number
+  AlignComments: boolean
+  AlignAssignments: boolean
+  FunctionStyle: FunctionStyle
+  DoubleBrackets: boolean
+}
+
+export interface PrintResult extends Report {
+  text: string
+}
+
 export interface IParseError {
   Filename?: string
   Incomplete: boolean
@@ -0,0 +1,172 @@
+import {
+  type GetWebAssemblyInstance,
+  type GetWebAssemblySource,
+  ParseError,
+} from './processor.js'
+import {
+  type IParseError,
+  type ShParserOptions,
+  type Workspace,
+  type WorkspaceSymbol,
+  LangVariant,
+} from './types.js'
+
+let encoder: TextEncoder | undefined
+let decoder: TextDecoder | undefined
+
+export const getWorkspace = (
+  getWasm: GetWebAssemblyInstance | GetWebAssemblySource,
+) => {
+  encoder ??= new TextEncoder()
+  decoder ??= new TextDecoder()
+
+  /**
+   * Creates a workspace holding the documents of several shell scripts in its
+   * own instance of the WebAssembly module, to resolve the variables and
+   * functions they share by including each other with `source` or `.`.
+   *
+   * @returns A promise that resolves to the {@link Workspace}, whose documents
+   *   are kept until they are removed.
+   */
+  return async function workspace(): Promise<Workspace> {
+    const go = new Go()
+
+    const wasm =
+      getWasm.length === 0
+        ? await WebAssembly.instantiate(
+            await Promise.resolve((getWasm as GetWebAssemblySource)()).then(
+              /* istanbul ignore next */ source =>
+                'arrayBuffer' in source ? source.arrayBuffer() : source,
+            ),
+            go.importObject,
+          )
+        : {
+            instance: await (getWasm as GetWebAssemblyInstance)(
+              go.importObject,
+            ),
+          }
+
+    // the go main() function has to stay alive to keep the documents
+    void go.run(wasm.instance)
+
+    const {
+      memory,
+      wasmAlloc,
+      wasmFree,
+      workspaceUpdate,
+      workspaceRemove,
+      workspaceSymbol,
+      workspaceUnusedFunctions,
+    } = wasm.instance.exports as {
+      memory: WebAssembly.Memory
+      wasmAlloc: (size: number) => number
+      wasmFree: (pointer: number) => void
+      workspaceUpdate: (
+        filePathPointer: number,
+        filePath0: number,
+        filePath1: number,
+
+        textPointer: number,
+        text0: number,
+        text1: number,
+
+        keepComments: boolean,
+        variant: LangVariant,
+        stopAtPointer: number,
+        stopAt0: number,
+        stopAt1: number,
+        recoverErrors: number,
+      ) => number
+      workspaceRemove: (
+        filePathPointer: number,
+        filePath0: number,
+        filePath1: number,
+      ) => void
+      workspaceSymbol: (
+        filePathPointer: number,
+        filePath0: number,
+        filePath1: number,
+        offset: number,
+      ) => number
+      workspaceUnusedFunctions: () => number
+    }
+
+    const alloc = (value: string) => {
+      const bytes = encoder!.encode(value)
+      const pointer = wasmAlloc(bytes.byteLength)
+      new Uint8Array(memory.buffer).set(bytes, pointer)
+      return [pointer, bytes.byteLength, bytes.byteLength] as const
+    }
+
+    const result = (resultPointer: number) => {
+      const bytes = new Uint8Array(memory.buffer).subarray(resultPointer)
+      const string = decoder!.decode(bytes.subarray(0, bytes.indexOf(0)))
+
+      const {
+        parseError,
+        message,
+        workspaceSymbol: symbol,
+        unusedFunctions: unused,
+      } = JSON.parse(string) as {
+        parseError: IParseError | null
+        message: string
+        workspaceSymbol: WorkspaceSymbol | null
+        unusedFunctions: WorkspaceSymbol[] | null
+      }
+
+      if (parseError || message) {
+        throw parseError == null
+          ? new SyntaxError(message)
+          : new ParseError(parseError)
+      }
+
+      return { symbol, unused }
+    }
+
+    return {
+      update(
+        filepath,
+        text,
+        {
+          keepComments = true,
+          variant,
+          stopAt = '',
+          recoverErrors = 0,
+        }: ShParserOptions = {},
+      ) {
+        const filePath = alloc(filepath)
+        const uText = alloc(text)
+        const uStopAt = alloc(stopAt)
+
+        const resultPointer = workspaceUpdate(
+          ...filePath,
+          ...uText,
+          keepComments,
+          variant ?? LangVariant.LangBash,
+          ...uStopAt,
+          recoverErrors,
+        )
+
+        wasmFree(filePath[0])
+        wasmFree(uText[0])
+        wasmFree(uStopAt[0])
+
+        result(resultPointer)
+      },
+      remove(filepath) {
+        const filePath = alloc(filepath)
+        workspaceRemove(...filePath)
+        wasmFree(filePath[0])
+      },
+      symbolAt(filepath, offset) {
+        const filePath = alloc(filepath)
+        const resultPointer = workspaceSymbol(...filePath, offset)
+        wasmFree(filePath[0])
+        return result(resultPointer).symbol
+      },
+      unusedFunctions() {
+        return result(workspaceUnusedFunctions()).unused ?? []
+      },
+    }
+  }
+}
@@ -0,0 +1,132 @@
+import { type Command, processor } from 'sh-syntax'
+
+describe('commands', () => {
+  const counts = (commands: Command[]) =>
+    commands.map(({ Name, Count }) => [Name, Count])
+
+  it('lists the builtins, functions and external programs run', async () => {
+    const inventory = await processor(
+      'command -v git >/dev/null && command git status\ncommand -pV ls\nsudo -u me env A=1 make\nf() { :; }\nf; command f; echo $(date)',
+      { commands: true },
+    )
+
+    expect(counts(inventory.Builtins)).toEqual([
+      ['command', 4],
+      [':', 1],
+      ['echo', 1],
+    ])
+    expect(counts(inventory.Functions)).toEqual([['f', 1]])
+    expect(counts(inventory.External)).toEqual([
+      ['git', 1],
+      ['sudo', 1],
+      ['env', 1],
+      ['make', 1],
+      ['f', 1],
+      ['date', 1],
+    ])
+    expect(inventory.External[0].Calls).toEqual([
+      {
+        Pos: { Offset: 37, Line: 1, Col: 38 },
+        End: { Offset: 40, Line: 1, Col: 41 },
+      },
+    ])
+  })
+
+  it('removes the quotes and escapes of the command names', async () => {
+    const inventory = await processor("\\cp a b\n'cp' c d\n\\echo hi", {
+      commands: true,
+    })
+
+    expect(counts(inventory.Builtins)).toEqual([['echo', 1]])
+    expect(counts(inventory.External)).toEqual([['cp', 2]])
+  })
+})
+
+describe('environment', () => {
+  it('lists the variables read without being assigned', async () => {
+    const variables = await processor(
+      'echo "${HOME}" ${PORT:-8080} ${TOKEN:?missing}\nx=1; echo $x $PATH $((N + 1))\n: "${CI:=false}"',
+      { environment: true },
+    )
+
+    expect(
+      variables.map(({ Name, Default, Required }) => [Name, Default, Required]),
+    ).toEqual([
+      ['HOME', false, false],
+      ['PORT', true, false],
+      ['TOKEN', false, true],
+      ['PATH', false, false],
+      ['N', false, false],
+      ['CI', true, false],
+    ])
+    expect(variables[1].Reads).toEqual([
+      {
+        Kind: 'expansion',
+        Operator: ':-',
+        Default: '8080',
+        Pos: { Offset: 17, Line: 1, Col: 18 },
+        End: { Offset: 21, Line: 1, Col: 22 },
+      },
+    ])
+    expect(variables[4].Reads[0].Kind).toBe('arithmetic')
+  })
+
+  it('leaves out the variables used by the shell itself', async () => {
+    const variables = await processor(
+      'printf %s "$IFS" "$PS4" $PPID $UID "${BASH_REMATCH[1]}" $TOKEN',
+      { environment: true },
+    )
+
+    expect(variables.map(({ Name }) => Name)).toEqual(['TOKEN'])
+  })
+})
+
+describe('includes', () => {
+  it('builds the include graph of the files in `files`', async () => {
+    const graph = await processor(
+      'source lib/a.sh\n. "$(dirname "$0")/b.sh"\n',
+      {
+        filepath: '/p/main.sh',
+        includes: true,
+        files: {
+          '/p/lib/a.sh': 'source c.sh\n. "${BASH_SOURCE%/*}/d.sh"\n',
+          '/p/b.sh': 'source "$x.sh"\n',
+          '/p/c.sh': 'echo c\n',
+          '/p/lib/d.sh': 'source lib/a.sh\nsource missing.sh\n',
+        },
+      },
+    )
+
+    expect(
+      graph.Files.map(({ Path, Includes }) => [
+        Path,
+        Includes.map(({ To }) => To),
+      ]),
+    ).toEqual([
+      ['/p/main.sh', ['/p/lib/a.sh', '/p/b.sh']],
+      ['/p/lib/a.sh', ['/p/c.sh', '/p/lib/d.sh']],
+      ['/p/b.sh', ['']],
+      ['/p/c.sh', []],
+      ['/p/lib/d.sh', ['/p/lib/a.sh', '']],
+    ])
+    expect(graph.Unresolved).toEqual([
+      {
+        From: '/p/b.sh',
+        To: '',
+        Text: '"$x.sh"',
+        Message: 'path is not a literal',
+        Pos: { Offset: 7, Line: 1, Col: 8 },
+        End: { Offset: 14, Line: 1, Col: 15 },
+      },
+      {
+        From: '/p/lib/d.sh',
+        To: '',
+        Text: 'missing.sh',
+        Message: 'file not found',
+        Pos: { Offset: 23, Line: 2, Col: 8 },
+        End: { Offset: 33, Line: 2, Col: 18 },
+      },
+    ])
+    expect(graph.Cycles).toEqual([['/p/lib/a.sh', '/p/lib/d.sh']])
+  })
+})
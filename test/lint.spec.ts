@@ -0,0 +1,75 @@
+import { lint } from 'sh-syntax'
+
+describe('lint', () => {
+  const backquote = {
+    Rule: 'backquote',
+    Severity: 'style',
+    Message: 'use $(...) instead of legacy backquotes',
+    Pos: { Offset: 2, Line: 1, Col: 3 },
+    End: { Offset: 8, Line: 1, Col: 9 },
+    Fix: {
+      Message: 'use $(...) instead of legacy backquotes',
+      OldText: '`date`',
+      NewText: '$(date)',
+      Pos: { Offset: 2, Line: 1, Col: 3 },
+      End: { Offset: 8, Line: 1, Col: 9 },
+    },
+  }
+
+  it('reports the diagnostics of the enabled rules', async () => {
+    await expect(lint('x=`date`')).resolves.toEqual([backquote])
+  })
+
+  it('configures the rules by ID', async () => {
+    await expect(
+      lint('x=`date`', { rules: { backquote: 'error' } }),
+    ).resolves.toEqual([{ ...backquote, Severity: 'error' }])
+    await expect(
+      lint('x=`date`', { rules: { backquote: false } }),
+    ).resolves.toEqual([])
+  })
+
+  it('rejects unknown rules', async () => {
+    await expect(lint('x=`date`', { rules: { nope: true } })).rejects.toThrow(
+      'unknown lint rule "nope"',
+    )
+  })
+})
+
+describe('unquoted-expansion', () => {
+  const unquoted = (OldText: string, Offset: number, Line: number) => {
+    const Col = Offset - (Line === 1 ? 0 : 23) + 1
+    const Pos = { Offset, Line, Col }
+    const End = {
+      Offset: Offset + OldText.length,
+      Line,
+      Col: Col + OldText.length,
+    }
+    return {
+      Rule: 'unquoted-expansion',
+      Severity: 'warning',
+      Message: 'quote this expansion to prevent word splitting and globbing',
+      Pos,
+      End,
+      Fix: {
+        Message: 'quote the expansion',
+        OldText,
+        NewText: `"${OldText}"`,
+        Pos,
+        End,
+      },
+    }
+  }
+
+  it('reports the unquoted expansions of arguments, redirects and for lists', async () => {
+    await expect(
+      lint(
+        'cp $src/* "$dst" >$log\nfor f in $(ls); do :; done\nx=$y\n[[ $a == b ]]\necho "$@" $# ${#a}',
+      ),
+    ).resolves.toEqual([
+      unquoted('$src', 3, 1),
+      unquoted('$log', 18, 1),
+      unquoted('$(ls)', 32, 2),
+    ])
+  })
+})
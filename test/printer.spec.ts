@@ -0,0 +1,656 @@
+import {
+  FunctionStyle,
+  LangVariant,
+  LineEnding,
+  ParseError,
+  print,
+  processor,
+} from 'sh-syntax'
+
+describe('printWidth', () => {
+  it('breaks long argument lists', async () => {
+    await expect(
+      print(
+        'docker run --rm --interactive --tty --volume "$PWD:/src" --workdir /src image make all',
+        { printWidth: 40 },
+      ),
+    ).resolves.toBe(`docker run --rm --interactive --tty \\
+  --volume "$PWD:/src" --workdir /src \\
+  image make all
+`)
+  })
+
+  it('breaks pipelines and `&&`/`||` chains before the operators', async () => {
+    await expect(
+      print('foo | bar | baz && qux || quux', { printWidth: 20 }),
+    ).resolves.toBe(`foo | bar | baz \\
+  && qux \\
+  || quux
+`)
+  })
+
+  it('breaks `&&`/`||` chains after the operators without binaryNextLine', async () => {
+    await expect(
+      print('foo | bar | baz && qux || quux', {
+        printWidth: 20,
+        binaryNextLine: false,
+      }),
+    ).resolves.toBe(`foo | bar | baz &&
+  qux ||
+  quux
+`)
+  })
+
+  it('leaves heredoc bodies as they are', async () => {
+    const text = `cat << EOF
+a very long heredoc line which cannot be broken at all
+EOF
+`
+    await expect(print(text, { printWidth: 20 })).resolves.toBe(text)
+  })
+})
+
+describe('simplify', () => {
+  it('reports the simplified nodes with their original positions', async () => {
+    const result = await processor(
+      'if [[ "$x" == "y" ]]; then echo $(( $a + 1 )); fi',
+      { print: true, report: true, simplify: true },
+    )
+
+    expect(result.text).toBe('if [[ $x == "y" ]]; then echo $((a + 1)); fi\n')
+    expect(result.simplifications).toEqual([
+      {
+        Node: 'BinaryTest',
+        Text: '"$x" == "y"',
+        Pos: { Offset: 6, Line: 1, Col: 7 },
+        End: { Offset: 17, Line: 1, Col: 18 },
+      },
+      {
+        Node: 'BinaryArithm',
+        Text: '$a + 1',
+        Pos: { Offset: 36, Line: 1, Col: 37 },
+        End: { Offset: 42, Line: 1, Col: 43 },
+      },
+    ])
+  })
+
+  it('reports nothing without simplify', async () => {
+    const result = await processor('echo $(( $a ))', {
+      print: true,
+      report: true,
+    })
+
+    expect(result.text).toBe('echo $(($a))\n')
+    expect(result.simplifications).toBeNull()
+  })
+})
+
+describe('quote style', () => {
+  const text = `echo "foo bar" "it's" "$x" $y $'plain' $'tab\\t' "a\\$b" $(( "1" + 1 ))`
+
+  it('rewrites quotes and parameter expansions', async () => {
+    await expect(
+      print(text, {
+        singleQuote: true,
+        braceParams: true,
+        plainAnsiCQuotes: true,
+      }),
+    ).resolves.toBe(
+      `echo 'foo bar' "it's" "\${x}" \${y} 'plain' $'tab\\t' 'a$b' $(("1" + 1))\n`,
+    )
+  })
+
+  it('keeps the quotes of heredoc bodies', async () => {
+    await expect(
+      print('cat <<EOF\n${x:-"q"} "a"\nEOF\necho ${x:-"q"}', {
+        singleQuote: true,
+      }),
+    ).resolves.toBe(`cat << EOF\n\${x:-"q"} "a"\nEOF\necho \${x:-'q'}\n`)
+  })
+
+  it('keeps quotes as they are by default', async () => {
+    await expect(print(text)).resolves.toBe(
+      `echo "foo bar" "it's" "$x" $y $'plain' $'tab\\t' "a\\$b" $(("1" + 1))\n`,
+    )
+  })
+})
+
+describe('reportBackquotes', () => {
+  const text = 'a=`echo \\`date\\` "x"`\nb=$(echo `id -u`)'
+
+  it('reports the outermost backquoted substitutions only', async () => {
+    const result = await processor(text, {
+      print: true,
+      report: true,
+      reportBackquotes: true,
+    })
+
+    expect(result.text).toBe('a=$(echo $(date) "x")\nb=$(echo $(id -u))\n')
+    expect(result.fixes).toEqual([
+      {
+        Message: 'use $(...) instead of legacy backquotes',
+        OldText: '`echo \\`date\\` "x"`',
+        NewText: '$(echo $(date) "x")',
+        Pos: { Offset: 2, Line: 1, Col: 3 },
+        End: { Offset: 21, Line: 1, Col: 22 },
+      },
+      {
+        Message: 'use $(...) instead of legacy backquotes',
+        OldText: '`id -u`',
+        NewText: '$(id -u)',
+        Pos: { Offset: 31, Line: 2, Col: 10 },
+        End: { Offset: 38, Line: 2, Col: 17 },
+      },
+    ])
+  })
+
+  it('converts without reporting by default', async () => {
+    const result = await processor(text, { print: true, report: true })
+
+    expect(result.text).toBe('a=$(echo $(date) "x")\nb=$(echo $(id -u))\n')
+    expect(result.fixes).toBeNull()
+  })
+})
+
+describe('sourceMap', () => {
+  it('maps the nodes of the original text to the printed text', async () => {
+    const result = await processor('echo   hi;   ls  -l', {
+      print: true,
+      report: true,
+      sourceMap: true,
+    })
+
+    expect(result.text).toBe('echo hi\nls -l\n')
+    expect(result.sourceMap).toHaveLength(7)
+    expect(result.sourceMap).toContainEqual({
+      Original: {
+        Pos: { Offset: 7, Line: 1, Col: 8 },
+        End: { Offset: 9, Line: 1, Col: 10 },
+      },
+      Generated: {
+        Pos: { Offset: 5, Line: 1, Col: 6 },
+        End: { Offset: 7, Line: 1, Col: 8 },
+      },
+    })
+    expect(result.sourceMap).toContainEqual({
+      Original: {
+        Pos: { Offset: 17, Line: 1, Col: 18 },
+        End: { Offset: 19, Line: 1, Col: 20 },
+      },
+      Generated: {
+        Pos: { Offset: 11, Line: 2, Col: 4 },
+        End: { Offset: 13, Line: 2, Col: 6 },
+      },
+    })
+  })
+
+  it('maps the nodes and fixes into the wrapped text with printWidth', async () => {
+    const result = await processor('x=`echo aaaaaaaa bbbbbbbbb`', {
+      print: true,
+      report: true,
+      sourceMap: true,
+      reportBackquotes: true,
+      printWidth: 20,
+    })
+
+    expect(result.text).toBe('x=$(echo aaaaaaaa \\\n  bbbbbbbbb)\n')
+    expect(result.fixes?.map(fix => fix.NewText)).toEqual([
+      '$(echo aaaaaaaa \\\n  bbbbbbbbb)',
+    ])
+    expect(result.sourceMap).toHaveLength(7)
+    expect(result.sourceMap).toContainEqual({
+      Original: {
+        Pos: { Offset: 17, Line: 1, Col: 18 },
+        End: { Offset: 26, Line: 1, Col: 27 },
+      },
+      Generated: {
+        Pos: { Offset: 22, Line: 2, Col: 3 },
+        End: { Offset: 31, Line: 2, Col: 12 },
+      },
+    })
+  })
+
+  it('maps nothing without sourceMap', async () => {
+    const result = await processor('echo   hi', { print: true, report: true })
+
+    expect(result.sourceMap).toBeNull()
+  })
+})
+
+describe('verify', () => {
+  it('accepts the rewrites of the printer options', async () => {
+    await expect(
+      print('foo() { echo "a b" $\'plain\' $x `date`; }', {
+        verify: true,
+        singleQuote: true,
+        braceParams: true,
+        plainAnsiCQuotes: true,
+        functionStyle: FunctionStyle.FunctionStyleKeyword,
+      }),
+    ).resolves.toBe("function foo { echo 'a b' 'plain' ${x} $(date); }\n")
+  })
+
+  it('accepts the rewrites of the printer itself', async () => {
+    const text = 'foo() { echo "a b" $\'plain\' ${x} `date`; }'
+
+    await expect(print(text, { verify: true })).resolves.toBe(
+      'foo() { echo "a b" $\'plain\' ${x} $(date); }\n',
+    )
+    await expect(print(text, { verify: true, minify: true })).resolves.toBe(
+      'foo(){ echo "a b" $\'plain\' $x $(date);}\n',
+    )
+  })
+
+  it('accepts the rewrites of simplify', async () => {
+    await expect(
+      print('[[ "$x" == "y" ]]', { verify: true, simplify: true }),
+    ).resolves.toBe('[[ $x == "y" ]]\n')
+  })
+})
+
+describe('fixedPoint', () => {
+  it('prints the output again until it no longer changes', async () => {
+    const result = await processor('echo `foo # c`', {
+      print: true,
+      report: true,
+      fixedPoint: true,
+    })
+
+    expect(result.text).toBe('echo $(\n  foo # c\n)\n')
+    expect(result.passes).toBe(2)
+    expect(result.diff).toBe(`--- pass 1
++++ pass 2
+@@ -1,2 +1,3 @@
+-echo $(foo # c
++echo $(
++  foo # c
+ )
+`)
+  })
+
+  it('needs a single pass for idempotent inputs', async () => {
+    const result = await processor('echo  foo', {
+      print: true,
+      report: true,
+      fixedPoint: true,
+    })
+
+    expect(result.text).toBe('echo foo\n')
+    expect(result.passes).toBe(1)
+    expect(result.diff).toBe('')
+  })
+})
+
+describe('checkComments', () => {
+  const text = 'if true # c\nthen :; fi'
+
+  it('warns about the comments moved by the printer', async () => {
+    const result = await processor(text, {
+      print: true,
+      report: true,
+      checkComments: true,
+    })
+
+    expect(result.text).toBe('if true; then # c\n  :\nfi\n')
+    expect(result.warnings).toEqual([
+      {
+        Message: 'comment attached to Stmt at 1:4 was moved to Stmt at 2:3',
+        Text: ' c',
+        Pos: { Offset: 8, Line: 1, Col: 9 },
+        End: { Offset: 11, Line: 1, Col: 12 },
+      },
+    ])
+  })
+
+  it('does not check the comments by default', async () => {
+    const result = await processor(text, { print: true, report: true })
+
+    expect(result.warnings).toBeNull()
+  })
+})
+
+describe('tolerant', () => {
+  const text = 'echo   a\nfoo )  bar\necho   b'
+
+  it('keeps the regions which cannot be parsed verbatim', async () => {
+    const result = await processor(text, {
+      print: true,
+      report: true,
+      tolerant: true,
+    })
+
+    expect(result.text).toBe('echo a\nfoo )  bar\necho b\n')
+    expect(result.parseErrors).toEqual([
+      {
+        Pos: { Offset: 13, Line: 2, Col: 5 },
+        Filename: '',
+        Text: 'a command can only contain words and redirects; encountered `)`',
+        Incomplete: false,
+      },
+    ])
+  })
+
+  it('keeps the rest of the text after an unclosed quote', async () => {
+    await expect(
+      print('echo   a\necho "unclosed\necho   b\n', { tolerant: true }),
+    ).resolves.toBe('echo a\necho "unclosed\necho   b\n')
+  })
+
+  it('warns about the regions which cannot be printed', async () => {
+    const result = await processor('foo() { :; }\nfoo )\n', {
+      print: true,
+      report: true,
+      tolerant: true,
+      variant: LangVariant.LangPOSIX,
+      functionStyle: FunctionStyle.FunctionStyleKeyword,
+    })
+
+    expect(result.text).toBe('foo() { :; }\nfoo )\n')
+    expect(result.warnings).toEqual([
+      {
+        Message: 'function style keyword cannot be used with variant posix',
+        Text: 'foo() { :; }\n',
+        Pos: { Offset: 0, Line: 1, Col: 1 },
+        End: { Offset: 13, Line: 2, Col: 1 },
+      },
+    ])
+  })
+
+  it('fails without tolerant', async () => {
+    await expect(print(text)).rejects.toThrow(ParseError)
+  })
+})
+
+describe('lineEnding', () => {
+  it('keeps the CRLF line breaks and byte-order mark of the input', async () => {
+    const result = await processor(
+      '\uFEFFif true;then\r\n  echo   hi\r\nfi\r\n',
+      { print: true, report: true },
+    )
+
+    expect(result.text).toBe('\uFEFFif true; then\r\n  echo hi\r\nfi\r\n')
+    expect(result.lineEnding).toBe('crlf')
+    expect(result.byteOrderMark).toBe(true)
+  })
+
+  it('uses the chosen line breaks, including within heredocs', async () => {
+    await expect(
+      print('cat <<EOF\r\na\r\nEOF\r\n', {
+        lineEnding: LineEnding.LineEndingLF,
+      }),
+    ).resolves.toBe('cat << EOF\na\nEOF\n')
+    await expect(
+      print('cat <<EOF\na\nEOF\n', {
+        lineEnding: LineEnding.LineEndingCRLF,
+      }),
+    ).resolves.toBe('cat << EOF\r\na\r\nEOF\r\n')
+  })
+
+  it('reports errors at their position in the original text', async () => {
+    await expect(print('echo "$x"\r\nfoo )\r\n')).rejects.toMatchObject({
+      Pos: { Offset: 15, Line: 2, Col: 5 },
+    })
+  })
+})
+
+describe('layout', () => {
+  it('keeps up to maxBlankLines blank lines', async () => {
+    const text = 'a=1\n\n\n# c\n\n\n\nb=2'
+
+    await expect(print(text, { maxBlankLines: 2 })).resolves.toBe(
+      'a=1\n\n\n# c\n\n\nb=2\n',
+    )
+    await expect(print(text, { maxBlankLines: 0 })).resolves.toBe(
+      'a=1\n\n# c\n\nb=2\n',
+    )
+  })
+
+  it('keeps the single blank line of the printer with maxBlankLines 1', async () => {
+    await expect(print('a=1\n\n\nb=2', { maxBlankLines: 1 })).resolves.toBe(
+      'a=1\n\nb=2\n',
+    )
+  })
+
+  it('aligns trailing comments', async () => {
+    await expect(
+      print('echo a # one\necho long words # two', { alignComments: true }),
+    ).resolves.toBe('echo a          # one\necho long words # two\n')
+  })
+
+  it('aligns trailing comments after aligning assignments', async () => {
+    await expect(
+      print(
+        'f() {\n  local a=1 # one\n  local foo=2 # two\n  local abcdefghij=3\n}',
+        { alignComments: true, alignAssignments: true },
+      ),
+    ).resolves.toBe(
+      'f() {\n  local          a=1 # one\n  local        foo=2 # two\n  local abcdefghij=3\n}\n',
+    )
+  })
+
+  it('does not indent plain assignments to align them', async () => {
+    await expect(print('a=1\nfoo=2', { alignAssignments: true })).resolves.toBe(
+      'a=1\nfoo=2\n',
+    )
+  })
+})
+
+describe('functionStyle', () => {
+  it('rewrites function declarations into the chosen style', async () => {
+    const result = await processor(
+      'foo() { :; }\nfunction bar { :; }\nfunction baz() (:)',
+      {
+        print: true,
+        report: true,
+        functionStyle: FunctionStyle.FunctionStyleKeyword,
+      },
+    )
+
+    expect(result.text).toBe(
+      'function foo { :; }\nfunction bar { :; }\nfunction baz() (:)\n',
+    )
+    expect(result.fixes).toEqual([
+      {
+        Message: 'use the keyword function declaration style',
+        OldText: 'foo()',
+        NewText: 'function foo',
+        Pos: { Offset: 0, Line: 1, Col: 1 },
+        End: { Offset: 5, Line: 1, Col: 6 },
+      },
+    ])
+  })
+
+  it('rewrites function declarations into the posix style', async () => {
+    await expect(
+      print('function foo { :; }', {
+        functionStyle: FunctionStyle.FunctionStylePOSIX,
+      }),
+    ).resolves.toBe('foo() { :; }\n')
+  })
+
+  it('refuses the `function` keyword with the posix variant', async () => {
+    await expect(
+      print('foo() { :; }', {
+        functionStyle: FunctionStyle.FunctionStyleKeyword,
+        variant: LangVariant.LangPOSIX,
+      }),
+    ).rejects.toThrow('function style keyword cannot be used with variant posix')
+  })
+})
+
+describe('doubleBrackets', () => {
+  it('rewrites `[` and `test` commands into `[[ ]]`', async () => {
+    const result = await processor(
+      'if [ -n "$a" ] && test "$b" = x; then :; fi',
+      { print: true, report: true, doubleBrackets: true },
+    )
+
+    expect(result.text).toBe('if [[ -n "$a" ]] && [[ "$b" = x ]]; then :; fi\n')
+    expect(result.fixes).toEqual([
+      {
+        Message: 'use [[ ]] instead of [ ] or test',
+        OldText: '[ -n "$a" ]',
+        NewText: '[[ -n "$a" ]]',
+        Pos: { Offset: 3, Line: 1, Col: 4 },
+        End: { Offset: 14, Line: 1, Col: 15 },
+      },
+      {
+        Message: 'use [[ ]] instead of [ ] or test',
+        OldText: 'test "$b" = x',
+        NewText: '[[ "$b" = x ]]',
+        Pos: { Offset: 18, Line: 1, Col: 19 },
+        End: { Offset: 31, Line: 1, Col: 32 },
+      },
+    ])
+  })
+
+  it.each([
+    ['[ ! "$a" ]', '[[ ! "$a" ]]'],
+    ['test ! -n "$a"', '[[ ! -n "$a" ]]'],
+    ['[ ! "$a" = x ]', '[[ ! "$a" = x ]]'],
+    ['[ $# -eq 0 ]', '[[ $# -eq 0 ]]'],
+  ])('rewrites `%s` into `%s`', async (text, expected) => {
+    await expect(print(text, { doubleBrackets: true })).resolves.toBe(
+      `${expected}\n`,
+    )
+  })
+
+  it.each([
+    '[ -n ]',
+    '[ -f ]',
+    'test !',
+    '[ ! -n ]',
+    '[ -f = ]',
+    '[ ! = x ]',
+    'test ! -n -a x',
+    '[ $a = b ]',
+    '[ a \\< b ]',
+    '[ "$a" -gt 1 ]',
+    '[ 010 -eq 8 ]',
+    '[ +8 -eq 8 ]',
+  ])('leaves `%s` as it is', async text => {
+    await expect(print(text, { doubleBrackets: true })).resolves.toBe(
+      `${text}\n`,
+    )
+  })
+})
+
+describe('preset', () => {
+  const text = 'case x in\na) foo &&\nbar;;\nesac'
+
+  it('applies the printer options of the preset', async () => {
+    await expect(print(text, { preset: 'google' })).resolves.toBe(
+      'case x in\n  a) foo \\\n    && bar ;;\nesac\n',
+    )
+    await expect(print(text, { preset: 'shfmt-default' })).resolves.toBe(
+      'case x in\na) foo &&\n\tbar ;;\nesac\n',
+    )
+  })
+
+  it('keeps the options which are set', async () => {
+    await expect(
+      print(text, { preset: 'shfmt-default', indent: 4 }),
+    ).resolves.toBe('case x in\na) foo &&\n    bar ;;\nesac\n')
+  })
+
+  it('rejects unknown presets', async () => {
+    // @ts-expect-error -- an unknown preset
+    await expect(print(text, { preset: 'nope' })).rejects.toThrow(
+      'unknown preset "nope", expected one of: compact, google, shfmt-default',
+    )
+  })
+})
+
+describe('editorConfigs', () => {
+  const text = 'if true; then\necho hi\nfi'
+  const editorConfigs = [
+    { dir: '/repo', text: 'root = true\n[*]\nindent_style = tab\n' },
+    {
+      dir: '/repo/src',
+      text: '[*.{sh,bash}]\nindent_style = space\nindent_size = 4\nend_of_line = crlf\n',
+    },
+  ]
+
+  it('applies the sections matching the file, innermost last', async () => {
+    await expect(
+      print(text, { filepath: '/repo/src/a.sh', editorConfigs }),
+    ).resolves.toBe('if true; then\r\n    echo hi\r\nfi\r\n')
+    await expect(
+      print(text, { filepath: '/repo/src/a.zsh', editorConfigs }),
+    ).resolves.toBe('if true; then\n\techo hi\nfi\n')
+  })
+
+  it('ignores the files of other directories', async () => {
+    await expect(
+      print(text, { filepath: '/other/a.sh', editorConfigs }),
+    ).resolves.toBe('if true; then\n  echo hi\nfi\n')
+  })
+
+  it('keeps the options which are set', async () => {
+    await expect(
+      print(text, { filepath: '/repo/a.sh', editorConfigs, indent: 3 }),
+    ).resolves.toBe('if true; then\n   echo hi\nfi\n')
+  })
+
+  it('keeps its options when applying a preset', async () => {
+    await expect(
+      print(text, {
+        filepath: '/repo/src/a.sh',
+        preset: 'google',
+        editorConfigs: [editorConfigs[1]],
+      }),
+    ).resolves.toBe('if true; then\r\n    echo hi\r\nfi\r\n')
+  })
+
+  it('drops the files before a root one', async () => {
+    await expect(
+      print(text, {
+        filepath: '/repo/a.sh',
+        editorConfigs: [editorConfigs[0], { dir: '/repo', text: 'root = true' }],
+      }),
+    ).resolves.toBe('if true; then\n  echo hi\nfi\n')
+  })
+})
+
+describe('modeline', () => {
+  it('applies the options of the modeline', async () => {
+    const result = await processor(
+      'if true; then\necho hi   # sh-syntax: indent=8 bogus\nfi',
+      { print: true, report: true },
+    )
+
+    expect(result.text).toBe(
+      'if true; then\n        echo hi # sh-syntax: indent=8 bogus\nfi\n',
+    )
+    expect(result.modeline).toBe('indent=8 bogus')
+    expect(result.warnings).toEqual([
+      {
+        Message: 'unknown modeline option "bogus"',
+        Text: 'bogus',
+        Pos: { Offset: 46, Line: 2, Col: 33 },
+        End: { Offset: 51, Line: 2, Col: 38 },
+      },
+    ])
+  })
+
+  it('takes precedence over the options which are set', async () => {
+    await expect(
+      print('# sh-syntax: indent=4\nif true; then\necho hi\nfi', {
+        indent: 2,
+      }),
+    ).resolves.toBe('# sh-syntax: indent=4\nif true; then\n    echo hi\nfi\n')
+  })
+
+  it.each([
+    'cat <<EOF\n# sh-syntax: indent=4\nEOF\n',
+    'echo "\n# sh-syntax: indent=4"\n',
+  ])('ignores modelines outside of comments in %j', async prefix => {
+    const result = await processor(`${prefix}if true; then\necho hi\nfi`, {
+      print: true,
+      report: true,
+    })
+
+    expect(result.text).toContain('\n  echo hi\n')
+    expect(result.modeline).toBe('')
+  })
+})
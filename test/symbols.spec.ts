@@ -0,0 +1,168 @@
+import { processor, symbols } from 'sh-syntax'
+
+const at = (Offset: number, Line: number, Col: number) => ({
+  Offset,
+  Line,
+  Col,
+})
+
+const occurrence = (
+  Kind: string,
+  Offset: number,
+  Line: number,
+  Col: number,
+) => ({
+  Kind,
+  Pos: at(Offset, Line, Col),
+  End: at(Offset + 1, Line, Col + 1),
+})
+
+describe('symbols', () => {
+  const text = 'x=1\nf() {\n  local x=2\n  echo "$x" $y\n}\nf'
+
+  it('builds the scope tree of the variables and functions', async () => {
+    await expect(symbols(text)).resolves.toEqual({
+      Kind: 'file',
+      Name: '',
+      Pos: at(0, 1, 1),
+      End: at(40, 6, 2),
+      Symbols: [
+        {
+          Name: 'x',
+          Kind: 'variable',
+          Definitions: [occurrence('assign', 0, 1, 1)],
+          References: [],
+        },
+        {
+          Name: 'f',
+          Kind: 'function',
+          Definitions: [occurrence('function', 4, 2, 1)],
+          References: [occurrence('call', 39, 6, 1)],
+        },
+        {
+          Name: 'y',
+          Kind: 'variable',
+          Definitions: [],
+          References: [occurrence('expansion', 35, 4, 14)],
+        },
+      ],
+      Children: [
+        {
+          Kind: 'function',
+          Name: 'f',
+          Pos: at(4, 2, 1),
+          End: at(38, 5, 2),
+          Symbols: [
+            {
+              Name: 'x',
+              Kind: 'variable',
+              Definitions: [occurrence('local', 18, 3, 9)],
+              References: [occurrence('expansion', 31, 4, 10)],
+            },
+          ],
+          Children: [],
+        },
+      ],
+    })
+  })
+})
+
+describe('symbol at offset', () => {
+  const text = 'x=1\nf() {\n  local x=2\n  echo "$x" $y\n}\nf\necho $x'
+
+  it('finds the definitions and references of a variable in its scope', async () => {
+    await expect(
+      processor(text, { symbols: true, offset: 31 }),
+    ).resolves.toEqual({
+      Name: 'x',
+      Kind: 'variable',
+      Definitions: [occurrence('local', 18, 3, 9)],
+      References: [occurrence('expansion', 31, 4, 10)],
+    })
+    await expect(
+      processor(text, { symbols: true, offset: 47 }),
+    ).resolves.toEqual({
+      Name: 'x',
+      Kind: 'variable',
+      Definitions: [occurrence('assign', 0, 1, 1)],
+      References: [occurrence('expansion', 47, 7, 7)],
+    })
+  })
+
+  it('finds the calls of a function', async () => {
+    await expect(
+      processor(text, { symbols: true, offset: 39 }),
+    ).resolves.toEqual({
+      Name: 'f',
+      Kind: 'function',
+      Definitions: [occurrence('function', 4, 2, 1)],
+      References: [occurrence('call', 39, 6, 1)],
+    })
+  })
+
+  it('returns null without any symbol at offset', async () => {
+    await expect(
+      processor(text, { symbols: true, offset: 2 }),
+    ).resolves.toBeNull()
+  })
+})
+
+describe('rename', () => {
+  const renamed = (
+    Message: string,
+    Offset: number,
+    Line: number,
+    Col: number,
+  ) => ({
+    Message,
+    OldText: Message.split(' ')[2],
+    NewText: Message.split(' ')[4],
+    Pos: at(Offset, Line, Col),
+    End: at(Offset + 1, Line, Col + 1),
+  })
+
+  it('renames every definition and reference of a variable in its scope', async () => {
+    const result = await processor(
+      'x=1\nf() {\n  local x=2\n  echo "$x" ${!ref}\n}\necho $x ${#x}',
+      { offset: 0, rename: 'count' },
+    )
+
+    expect(result).toEqual({
+      fixes: [
+        renamed('rename variable x to count', 0, 1, 1),
+        renamed('rename variable x to count', 50, 6, 7),
+        renamed('rename variable x to count', 55, 6, 12),
+      ],
+      warnings: [
+        {
+          Message: 'indirect expansion may refer to x by name',
+          Text: '${!ref}',
+          Pos: at(34, 4, 13),
+          End: at(41, 4, 20),
+        },
+      ],
+    })
+  })
+
+  it('renames a function with its calls', async () => {
+    const result = await processor('f() { :; }\nf\nunset -f f', {
+      offset: 0,
+      rename: 'g',
+    })
+
+    expect(result).toEqual({
+      fixes: [
+        renamed('rename function f to g', 0, 1, 1),
+        renamed('rename function f to g', 11, 2, 1),
+        renamed('rename function f to g', 22, 3, 10),
+      ],
+      warnings: [],
+    })
+  })
+
+  it('rejects invalid names', async () => {
+    await expect(
+      processor('x=1', { offset: 0, rename: '1bad' }),
+    ).rejects.toThrow('"1bad" is not a valid variable name')
+  })
+})
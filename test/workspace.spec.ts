@@ -0,0 +1,142 @@
+import fs from 'node:fs/promises'
+import path from 'node:path'
+
+import { ParseError, workspace } from 'sh-syntax'
+
+const location = (
+  Path: string,
+  Kind: string,
+  Offset: number,
+  Line: number,
+  Col: number,
+  length: number,
+) => ({
+  Path,
+  Kind,
+  Pos: { Offset, Line, Col },
+  End: { Offset: Offset + length, Line, Col: Col + length },
+})
+
+describe('workspace', () => {
+  it('is exported by the WebAssembly module', async () => {
+    const buffer = await fs.readFile(
+      path.resolve(import.meta.dirname, '../main.wasm'),
+    )
+    const exports = WebAssembly.Module.exports(new WebAssembly.Module(buffer))
+
+    expect(exports.map(({ name }) => name)).toEqual(
+      expect.arrayContaining([
+        'workspaceUpdate',
+        'workspaceRemove',
+        'workspaceSymbol',
+        'workspaceUnusedFunctions',
+      ]),
+    )
+  })
+
+  it('finds the symbols shared by included documents', async () => {
+    const documents = await workspace()
+
+    documents.update('/p/main.sh', 'source ./lib.sh\ngreet\n')
+    documents.update('/p/lib.sh', 'greet() { echo hi; }\nunused() { :; }\n')
+
+    expect(documents.symbolAt('/p/main.sh', 16)).toEqual({
+      Name: 'greet',
+      Kind: 'function',
+      Definitions: [location('/p/lib.sh', 'function', 0, 1, 1, 5)],
+      References: [location('/p/main.sh', 'call', 16, 2, 1, 5)],
+    })
+    expect(documents.unusedFunctions()).toEqual([
+      {
+        Name: 'unused',
+        Kind: 'function',
+        Definitions: [location('/p/lib.sh', 'function', 21, 2, 1, 6)],
+        References: [],
+      },
+    ])
+  })
+
+  it('keeps the documents which do not include each other apart', async () => {
+    const documents = await workspace()
+
+    documents.update('/a/lib.sh', 'f() { :; }\n')
+    documents.update('/a/main.sh', 'g() { :; }\n. ./lib.sh\n')
+    documents.update('/b/main.sh', 'f() { :; }\nf\n')
+
+    expect(documents.unusedFunctions()).toEqual([
+      {
+        Name: 'f',
+        Kind: 'function',
+        Definitions: [location('/a/lib.sh', 'function', 0, 1, 1, 1)],
+        References: [],
+      },
+      {
+        Name: 'g',
+        Kind: 'function',
+        Definitions: [location('/a/main.sh', 'function', 0, 1, 1, 1)],
+        References: [],
+      },
+    ])
+  })
+
+  it('resolves included paths against the script being run', async () => {
+    const documents = await workspace()
+
+    documents.update('/p/main.sh', 'source lib/a.sh\necho "$VERSION"\n')
+    documents.update(
+      '/p/lib/a.sh',
+      'source lib/b.sh\nsource "${BASH_SOURCE%/*}/c.sh"\n',
+    )
+    documents.update('/p/lib/b.sh', 'VERSION=1\n')
+    documents.update('/p/lib/c.sh', 'VERSION=2\n')
+
+    const symbol = {
+      Name: 'VERSION',
+      Kind: 'variable',
+      Definitions: [
+        location('/p/lib/b.sh', 'assign', 0, 1, 1, 7),
+        location('/p/lib/c.sh', 'assign', 0, 1, 1, 7),
+      ],
+      References: [location('/p/main.sh', 'expansion', 23, 2, 8, 7)],
+    }
+
+    expect(documents.symbolAt('/p/main.sh', 23)).toEqual(symbol)
+
+    documents.remove('/p/lib/c.sh')
+
+    expect(documents.symbolAt('/p/main.sh', 23)).toEqual({
+      ...symbol,
+      Definitions: [location('/p/lib/b.sh', 'assign', 0, 1, 1, 7)],
+    })
+  })
+
+  it('keeps a document which cannot be parsed without any symbol', async () => {
+    const documents = await workspace()
+
+    documents.update('/p/main.sh', 'source ./lib.sh\ngreet\n')
+
+    expect(() => documents.update('/p/lib.sh', 'greet() {\n')).toThrow(
+      ParseError,
+    )
+    expect(documents.symbolAt('/p/main.sh', 16)).toEqual({
+      Name: 'greet',
+      Kind: 'function',
+      Definitions: [],
+      References: [location('/p/main.sh', 'call', 16, 2, 1, 5)],
+    })
+
+    documents.update('/p/lib.sh', 'greet() { :; }\n')
+
+    expect(documents.symbolAt('/p/main.sh', 16)?.Definitions).toEqual([
+      location('/p/lib.sh', 'function', 0, 1, 1, 5),
+    ])
+  })
+
+  it('returns null outside of any name', async () => {
+    const documents = await workspace()
+
+    documents.update('/p/main.sh', 'echo hi\n')
+
+    expect(documents.symbolAt('/p/main.sh', 6)).toBeNull()
+  })
+})